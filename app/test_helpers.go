@@ -75,6 +75,12 @@ func setup(withGenesis bool, invCheckPeriod uint) (*BudgetApp, GenesisState) {
 	return app, GenesisState{}
 }
 
+// SetupTestingApp initializes a new BudgetApp with the default genesis state, so that it can be used
+// as the app of the chains of the IBC testing package.
+func SetupTestingApp() (*BudgetApp, GenesisState) {
+	return setup(true, 5)
+}
+
 // Setup initializes a new BudgetApp. A Nop logger is set in BudgetApp.
 func Setup(isCheckTx bool) *BudgetApp {
 	app, genesisState := setup(!isCheckTx, 5)
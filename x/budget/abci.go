@@ -10,9 +10,16 @@ import (
 	"github.com/tendermint/budget/x/budget/types"
 )
 
-// BeginBlocker collects budgets for the current block
+// BeginBlocker prunes the collection records out of the history retention, updates the lifecycle
+// statuses of budgets, returns the unbonded coins of archived budgets to their sources, and collects
+// budgets for the current block
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+	k.PruneCollectionRecords(ctx)
+	k.UpdateBudgetStatuses(ctx)
+	if err := k.ReleaseUnbondedBudgets(ctx); err != nil {
+		panic(err)
+	}
 	err := k.CollectBudgets(ctx)
 	if err != nil {
 		panic(err)
@@ -8,6 +8,12 @@ const (
 	FlagName               = "name"
 	FlagSourceAddress      = "source-address"
 	FlagDestinationAddress = "destination-address"
+	FlagCollectible        = "collectible"
+	FlagTag                = "tag"
+	FlagStatus             = "status"
+	FlagOrderBy            = "order-by"
+	FlagOrderDenom         = "order-denom"
+	FlagBlockInterval      = "block-interval"
 	FlagType               = "type"
 	FlagModuleName         = "module-name"
 )
@@ -19,6 +25,11 @@ func flagSetBudgets() *flag.FlagSet {
 	fs.String(FlagName, "", "The budget name")
 	fs.String(FlagSourceAddress, "", "The bech32 address of the source account")
 	fs.String(FlagDestinationAddress, "", "The bech32 address of the destination account")
+	fs.Bool(FlagCollectible, false, "Query only the budgets collectible at the current block time and height")
+	fs.String(FlagTag, "", "Query only the budgets with the tag")
+	fs.String(FlagStatus, "", "Query only the budgets with the status (upcoming|active|expired|removed), including the archived budgets for expired and removed")
+	fs.String(FlagOrderBy, "", "Order the budgets by (id|name|start-time|total-collected), default id")
+	fs.String(FlagOrderDenom, "", "The denom of the total collected coins to order the budgets by for total-collected")
 
 	return fs
 }
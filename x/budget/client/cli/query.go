@@ -28,6 +28,9 @@ func GetQueryCmd() *cobra.Command {
 	budgetQueryCmd.AddCommand(
 		GetCmdQueryParams(),
 		GetCmdQueryBudgets(),
+		GetCmdQueryCollectionHistory(),
+		GetCmdQueryProjections(),
+		GetCmdQueryTotalBurnedCoins(),
 		GetCmdQueryAddress(),
 	)
 
@@ -87,11 +90,19 @@ $ %s query %s budgets
 $ %s query %s budgets --name ...
 $ %s query %s budgets --source-address %s1zaavvzxez0elundtn32qnk9lkm8kmcszzsv80v
 $ %s query %s budgets --destination-address %s1zaavvzxez0elundtn32qnk9lkm8kmcszzsv80v
+$ %s query %s budgets --collectible
+$ %s query %s budgets --tag liquidity-farming
+$ %s query %s budgets --status expired
+$ %s query %s budgets --order-by total-collected --order-denom stake --reverse --limit 10
 `,
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName,
 				version.AppName, types.ModuleName, sdk.Bech32MainPrefix,
 				version.AppName, types.ModuleName, sdk.Bech32MainPrefix,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -100,9 +111,35 @@ $ %s query %s budgets --destination-address %s1zaavvzxez0elundtn32qnk9lkm8kmcszz
 				return err
 			}
 
+			var budgetStatus types.BudgetStatus
+			if statusStr, _ := cmd.Flags().GetString(FlagStatus); statusStr != "" {
+				status, ok := types.BudgetStatus_value["BUDGET_STATUS_"+strings.ToUpper(statusStr)]
+				if !ok {
+					return fmt.Errorf("invalid budget status %s", statusStr)
+				}
+				budgetStatus = types.BudgetStatus(status)
+			}
+
+			var orderBy types.BudgetOrder
+			if orderStr, _ := cmd.Flags().GetString(FlagOrderBy); orderStr != "" {
+				order, ok := types.BudgetOrder_value["BUDGET_ORDER_"+strings.ToUpper(strings.ReplaceAll(orderStr, "-", "_"))]
+				if !ok {
+					return fmt.Errorf("invalid budget order %s", orderStr)
+				}
+				orderBy = types.BudgetOrder(order)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			name, _ := cmd.Flags().GetString(FlagName)
 			sourceAddr, _ := cmd.Flags().GetString(FlagSourceAddress)
 			destinationAddr, _ := cmd.Flags().GetString(FlagDestinationAddress)
+			collectible, _ := cmd.Flags().GetBool(FlagCollectible)
+			tag, _ := cmd.Flags().GetString(FlagTag)
+			orderDenom, _ := cmd.Flags().GetString(FlagOrderDenom)
 
 			queryClient := types.NewQueryClient(clientCtx)
 			res, err := queryClient.Budgets(
@@ -111,6 +148,12 @@ $ %s query %s budgets --destination-address %s1zaavvzxez0elundtn32qnk9lkm8kmcszz
 					Name:               name,
 					SourceAddress:      sourceAddr,
 					DestinationAddress: destinationAddr,
+					Collectible:        collectible,
+					Tag:                tag,
+					Status:             budgetStatus,
+					Pagination:         pageReq,
+					OrderBy:            orderBy,
+					OrderDenom:         orderDenom,
 				},
 			)
 			if err != nil {
@@ -122,6 +165,147 @@ $ %s query %s budgets --destination-address %s1zaavvzxez0elundtn32qnk9lkm8kmcszz
 	}
 
 	cmd.Flags().AddFlagSet(flagSetBudgets())
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "budgets")
+
+	return cmd
+}
+
+// GetCmdQueryCollectionHistory implements the collection history query command.
+func GetCmdQueryCollectionHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history [budget-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the collection records of a budget",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the collection records of a budget within the history retention.
+
+Example:
+$ %s query %s history 1
+$ %s query %s history 1 --limit 10 --reverse
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			budgetID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("budget-id %s not a valid uint, please input a valid budget-id", args[0])
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CollectionHistory(
+				context.Background(),
+				&types.QueryCollectionHistoryRequest{
+					BudgetId:   budgetID,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "history")
+
+	return cmd
+}
+
+// GetCmdQueryProjections implements the projections query command.
+func GetCmdQueryProjections() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projections",
+		Args:  cobra.NoArgs,
+		Short: "Query the next collection of each active budget",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the next collection height and its estimated time, the current source balances, and the
+coins that would be collected at the next collection of each active budget, if the state does not change until then.
+The times are estimated with the expected time between blocks, which is 6s by default.
+
+Example:
+$ %s query %s projections
+$ %s query %s projections --block-interval 5s
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			blockInterval, _ := cmd.Flags().GetDuration(FlagBlockInterval)
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Projections(
+				context.Background(),
+				&types.QueryProjectionsRequest{BlockInterval: blockInterval},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Duration(FlagBlockInterval, 0, "The expected time between blocks to estimate the times of the collections")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryTotalBurnedCoins implements the total burned coins query command.
+func GetCmdQueryTotalBurnedCoins() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-burned-coins",
+		Args:  cobra.NoArgs,
+		Short: "Query the total coins burned by all budgets",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the total coins burned by all budgets.
+
+Example:
+$ %s query %s total-burned-coins
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TotalBurnedCoins(
+				context.Background(),
+				&types.QueryTotalBurnedCoinsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/budget/x/budget/types"
+)
+
+// GetCmdSubmitBudgetProposal implements the command to submit a budget proposal.
+func GetCmdSubmitBudgetProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "budget [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a budget proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a budget proposal to add, update and delete budgets along with an initial deposit.
+The proposal details must be supplied via a JSON file. The budgets to update are replaced as a whole,
+and are identified by their ids which are assigned when the budgets are added.
+
+Example:
+$ %s tx gov submit-proposal budget <path/to/proposal.json> --deposit 10000000stake --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Budget Proposal",
+  "description": "Add a budget and delete budget 2",
+  "add_budgets": [
+    {
+      "name": "gravity-dex-farming-20213Q-20313Q",
+      "rate": "0.500000000000000000",
+      "source_address": "cosmos17xpfvakm2amg962yls6f84z3kell8c5lserqta",
+      "destination_address": "cosmos1228ryjucdpdv3t87rxle0ew76a56ulvnfst0hq0sscd3nafgjpqqkcxcky",
+      "start_time": "2021-09-01T00:00:00Z",
+      "end_time": "2031-09-30T00:00:00Z"
+    }
+  ],
+  "update_budgets": [],
+  "delete_budget_ids": ["2"]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var content types.BudgetProposal
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &content); err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(&content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
@@ -0,0 +1,11 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/tendermint/budget/x/budget/client/cli"
+	"github.com/tendermint/budget/x/budget/client/rest"
+)
+
+// ProposalHandler is the budget proposal command handler.
+var ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitBudgetProposal, rest.ProposalRESTHandler)
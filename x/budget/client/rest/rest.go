@@ -0,0 +1,24 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+)
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the budget proposal REST handler with a given sub-route.
+// Budget proposals are not supported by the legacy REST routes, they can be submitted through the CLI or gRPC.
+func ProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "budget",
+		Handler:  postBudgetProposalHandlerFn(clientCtx),
+	}
+}
+
+func postBudgetProposalHandlerFn(_ client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, "Legacy REST Routes are not supported for budget proposals")
+	}
+}
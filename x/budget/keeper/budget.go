@@ -1,20 +1,195 @@
 package keeper
 
 import (
+	"math"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/tendermint/budget/x/budget/types"
 )
 
-// CollectBudgets collects all the valid budgets registered in params.Budgets and
+// ResolveAddress resolves the bech32 address or the address reference to an account address.
+// A module address reference is resolved through the account keeper, so that it must refer to
+// a module account registered in the app.
+func (k Keeper) ResolveAddress(address string) (sdk.AccAddress, error) {
+	if moduleName, ok := types.ParseModuleReference(address); ok {
+		addr := k.accountKeeper.GetModuleAddress(moduleName)
+		if addr == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "module account %s does not exist", moduleName)
+		}
+		return addr, nil
+	}
+	return types.ResolveAddress(address)
+}
+
+// ResolveBudget returns the budget with its source and destination addresses resolved to bech32 addresses.
+// A community pool destination is resolved to the address of the distribution module account, and
+// a burn destination to the address of the budget module account that burns the coins, a staking
+// destination to the address of the delegator account of the budget, and an IBC transfer destination
+// to the address of the IBC escrow account of the budget.
+func (k Keeper) ResolveBudget(budget types.Budget) (types.Budget, error) {
+	sourceAcc, err := k.ResolveAddress(budget.SourceAddress)
+	if err != nil {
+		return types.Budget{}, sdkerrors.Wrapf(err, "invalid source address %s", budget.SourceAddress)
+	}
+	budget.SourceAddress = sourceAcc.String()
+	if budget.DestinationAddress != "" {
+		destinationAcc, err := k.ResolveAddress(budget.DestinationAddress)
+		if err != nil {
+			return types.Budget{}, sdkerrors.Wrapf(err, "invalid destination address %s", budget.DestinationAddress)
+		}
+		budget.DestinationAddress = destinationAcc.String()
+	}
+	destinations := make([]types.BudgetDestination, len(budget.Destinations))
+	for i, destination := range budget.Destinations {
+		address := destination.Address
+		switch destination.Type {
+		case types.DestinationTypeCommunityPool:
+			address = types.ModuleAddressReferencePrefix + distrtypes.ModuleName
+		case types.DestinationTypeBurn:
+			address = types.ModuleAddressReferencePrefix + types.ModuleName
+		case types.DestinationTypeStaking:
+			address = types.DelegatorAddress(budget.ID).String()
+		case types.DestinationTypeIBCTransfer:
+			address = types.IBCEscrowAddress(budget.ID).String()
+		}
+		destinationAcc, err := k.ResolveAddress(address)
+		if err != nil {
+			return types.Budget{}, sdkerrors.Wrapf(err, "invalid destination address %s", address)
+		}
+		destination.Address = destinationAcc.String()
+		if destination.RewardsAddress != "" {
+			rewardsAcc, err := k.ResolveAddress(destination.RewardsAddress)
+			if err != nil {
+				return types.Budget{}, sdkerrors.Wrapf(err, "invalid rewards address %s", destination.RewardsAddress)
+			}
+			destination.RewardsAddress = rewardsAcc.String()
+		}
+		destinations[i] = destination
+	}
+	if len(destinations) > 0 {
+		budget.Destinations = destinations
+	}
+	return budget, nil
+}
+
+// FailedCondition returns the name of the first condition of the budget that does not hold,
+// or an empty string if all the conditions hold.
+func (k Keeper) FailedCondition(ctx sdk.Context, budget types.Budget) (string, error) {
+	if budget.Conditions == nil {
+		return "", nil
+	}
+	conditions := *budget.Conditions
+	if !conditions.MinSourceBalance.Empty() {
+		sourceAcc, err := k.ResolveAddress(budget.SourceAddress)
+		if err != nil {
+			return "", err
+		}
+		if !conditions.MinSourceBalanceHolds(k.bankKeeper.GetAllBalances(ctx, sourceAcc)) {
+			return types.ConditionMinSourceBalance, nil
+		}
+	}
+	if !conditions.MaxDestinationBalance.Empty() {
+		for _, destination := range budget.CollectionDestinations() {
+			destinationAcc, err := k.ResolveAddress(destination.Address)
+			if err != nil {
+				return "", err
+			}
+			if !conditions.MaxDestinationBalanceHolds(k.bankKeeper.GetAllBalances(ctx, destinationAcc)) {
+				return types.ConditionMaxDestinationBalance, nil
+			}
+		}
+	}
+	if conditions.HasMinBondedRatio() || conditions.HasMaxBondedRatio() {
+		bondedRatio := k.stakingKeeper.BondedRatio(ctx)
+		if !conditions.MinBondedRatioHolds(bondedRatio) {
+			return types.ConditionMinBondedRatio, nil
+		}
+		if !conditions.MaxBondedRatioHolds(bondedRatio) {
+			return types.ConditionMaxBondedRatio, nil
+		}
+	}
+	return "", nil
+}
+
+// CollectBudgets collects all the valid budgets in the store and
 // distributes the total collected coins to destination address.
 func (k Keeper) CollectBudgets(ctx sdk.Context) error {
+	return k.collectBudgets(ctx, nil)
+}
+
+// collectBudgets collects the budgets as CollectBudgets does, and calls onCollect, if it is not nil, with the
+// collections of the budgets of each source before they are applied.
+func (k Keeper) collectBudgets(ctx sdk.Context, onCollect func(collections []types.BudgetCollection)) error {
 	params := k.GetParams(ctx)
+	isTimeEpoch := false
+	switch params.EpochMode {
+	case types.EpochModeBlocks:
+		if params.EpochBlocks == 0 {
+			return nil
+		}
+	case types.EpochModeDuration:
+		if params.EpochDuration == 0 {
+			return nil
+		}
+		var epochTime time.Time
+		epochTime, isTimeEpoch = types.EpochBoundary(k.GetLastEpochTime(ctx), ctx.BlockTime(), params.EpochDuration)
+		// The last epoch time only changes on the first block of an epoch, including the first block ever.
+		if isTimeEpoch {
+			k.SetLastEpochTime(ctx, epochTime)
+		}
+	}
+
 	var budgets []types.Budget
-	if params.EpochBlocks > 0 && ctx.BlockHeight()%int64(params.EpochBlocks) == 0 {
-		budgets = types.CollectibleBudgets(params.Budgets, ctx.BlockTime())
+	for _, budget := range types.CollectibleBudgets(k.GetAllBudgets(ctx), ctx.BlockTime(), ctx.BlockHeight()) {
+		// A recurring budget collects on the first block of each of its periods regardless of the epoch.
+		var period uint64
+		if budget.Recurrence != nil {
+			period = budget.Recurrence.Period(budget.StartTime, ctx.BlockTime())
+			if period < k.GetNextPeriod(ctx, budget.ID) {
+				continue
+			}
+		} else if budget.EpochBlocks == 0 && params.EpochMode == types.EpochModeDuration {
+			// A budget with its own epoch length keeps collecting by blocks in the duration epoch mode.
+			if !isTimeEpoch {
+				continue
+			}
+		} else if !budget.Due(ctx.BlockHeight(), params.EpochBlocks, k.GetLastCollectedHeight(ctx, budget.ID)) {
+			continue
+		}
+		// A budget skipped by its conditions does not use up the period of its recurrence.
+		condition := ""
+		if resolved, err := k.ResolveBudget(budget); err != nil {
+			k.Logger(ctx).Error("failed to resolve budget addresses", "name", budget.Name, "error", err)
+			condition = types.ConditionResolvableAddresses
+		} else {
+			budget = resolved
+			if condition, err = k.FailedCondition(ctx, budget); err != nil {
+				return err
+			}
+		}
+		if condition != "" {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeBudgetSkipped,
+					sdk.NewAttribute(types.AttributeValueName, budget.Name),
+					sdk.NewAttribute(types.AttributeValueCondition, condition),
+				),
+			)
+			continue
+		}
+		if budget.Recurrence != nil {
+			k.SetNextPeriod(ctx, budget.ID, period+1)
+		}
+		k.SetLastCollectedHeight(ctx, budget.ID, ctx.BlockHeight())
+		budgets = append(budgets, budget.Scheduled(ctx.BlockTime()))
 	}
+	types.SortBudgetsByPriority(budgets)
 	if len(budgets) == 0 {
 		return nil
 	}
@@ -22,60 +197,801 @@ func (k Keeper) CollectBudgets(ctx sdk.Context) error {
 	// Get a map GetBudgetsBySourceMap that has a list of budgets and their total rate, which
 	// contain the same SourceAddress
 	budgetsBySourceMap := types.GetBudgetsBySourceMap(budgets)
-	for source, budgetsBySource := range budgetsBySourceMap {
+	for _, source := range budgetsBySourceMap.Sources() {
+		budgetsBySource := budgetsBySourceMap[source]
 		sourceAcc, err := sdk.AccAddressFromBech32(source)
 		if err != nil {
 			return err
 		}
-		sourceBalances := sdk.NewDecCoinsFromCoins(k.bankKeeper.GetAllBalances(ctx, sourceAcc)...)
+		sourceBalances := k.bankKeeper.GetAllBalances(ctx, sourceAcc)
 		if sourceBalances.IsZero() {
 			continue
 		}
 
+		var budgets []types.Budget
+		var totalCollectedCoins []sdk.Coins
+		var remainders []sdk.DecCoins
+		sourceReserve := params.SourceReserve(source)
+		for _, budget := range budgetsBySource.Budgets {
+			collectedCoins := k.GetTotalCollectedCoins(ctx, budget.ID)
+			if budget.Exhausted(collectedCoins) {
+				continue
+			}
+			// The budget leaves the larger of its own reserve and the reserve of the source in the source.
+			budget.Reserve = types.MaxCoins(budget.Reserve, sourceReserve)
+			budgets = append(budgets, budget)
+			totalCollectedCoins = append(totalCollectedCoins, collectedCoins)
+			remainders = append(remainders, k.GetRemainder(ctx, budget.ID))
+		}
+
+		var collections []types.BudgetCollection
+		switch params.ProcessingMode(source) {
+		case types.ProcessingModeSequential:
+			collections = types.SequentialCollections(budgets, sourceBalances, totalCollectedCoins, remainders)
+		default:
+			collections = types.Collections(budgets, sourceBalances, totalCollectedCoins, remainders)
+		}
+		if onCollect != nil {
+			onCollect(collections)
+		}
+
 		var inputs []banktypes.Input
 		var outputs []banktypes.Output
-		budgetsBySource.CollectionCoins = make([]sdk.Coins, len(budgetsBySource.Budgets))
-		for i, budget := range budgetsBySource.Budgets {
-			destinationAcc, err := sdk.AccAddressFromBech32(budget.DestinationAddress)
-			if err != nil {
+		var communityPoolCoins, burnCoins sdk.Coins
+		for _, collection := range collections {
+			if collection.Coins.Empty() || !collection.Coins.IsValid() {
+				continue
+			}
+
+			var sentCoins sdk.Coins
+			for i, destination := range collection.Budget.CollectionDestinations() {
+				destinationAcc, err := sdk.AccAddressFromBech32(destination.Address)
+				if err != nil {
+					return err
+				}
+				if collection.DestinationCoins[i].Empty() {
+					continue
+				}
+				if destination.Type == types.DestinationTypeCommunityPool {
+					communityPoolCoins = communityPoolCoins.Add(collection.DestinationCoins[i]...)
+					continue
+				}
+				// The coins to burn are sent to the budget module account, which burns them.
+				if destination.Type == types.DestinationTypeBurn {
+					burnCoins = burnCoins.Add(collection.DestinationCoins[i]...)
+				}
+				outputs = append(outputs, banktypes.NewOutput(destinationAcc, collection.DestinationCoins[i]))
+				sentCoins = sentCoins.Add(collection.DestinationCoins[i]...)
+			}
+			if !sentCoins.Empty() {
+				inputs = append(inputs, banktypes.NewInput(sourceAcc, sentCoins))
+			}
+		}
+
+		if len(inputs) > 0 {
+			if err := k.bankKeeper.InputOutputCoins(ctx, inputs, outputs); err != nil {
 				return err
 			}
+		}
+		// The coins for the community pool are sent through the distribution module so that the fee pool
+		// is credited with them.
+		if !communityPoolCoins.Empty() {
+			if err := k.distrKeeper.FundCommunityPool(ctx, communityPoolCoins, sourceAcc); err != nil {
+				return err
+			}
+		}
+		if !burnCoins.Empty() {
+			if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnCoins); err != nil {
+				return err
+			}
+			k.AddTotalBurnedCoins(ctx, burnCoins)
+		}
+		// The coins sent to the delegator accounts of the budgets are delegated, and the coins sent to
+		// the IBC escrow accounts are transferred, once they are received.
+		for _, collection := range collections {
+			for i, destination := range collection.Budget.CollectionDestinations() {
+				if collection.DestinationCoins[i].Empty() {
+					continue
+				}
+				switch destination.Type {
+				case types.DestinationTypeStaking:
+					if err := k.Delegate(ctx, collection.Budget, destination); err != nil {
+						return err
+					}
+				case types.DestinationTypeIBCTransfer:
+					if err := k.SendIBCTransfer(ctx, collection.Budget, *destination.IBCTransfer); err != nil {
+						return err
+					}
+				}
+			}
+		}
 
-			collectionCoins, _ := sourceBalances.MulDecTruncate(budget.Rate).TruncateDecimal()
-			if collectionCoins.Empty() || !collectionCoins.IsValid() {
-				continue
+		for _, collection := range collections {
+			budget := collection.Budget
+			k.AddTotalCollectedCoins(ctx, budget.ID, collection.Coins)
+			k.SetRemainder(ctx, budget.ID, collection.Remainder)
+			// The budgets that collect nothing, for example from a source shared with other budgets, are not recorded.
+			if params.HistoryRetentionBlocks > 0 && !collection.Coins.Empty() {
+				k.SetCollectionRecord(ctx, types.CollectionRecord{
+					BudgetID:       budget.ID,
+					Height:         ctx.BlockHeight(),
+					Time:           ctx.BlockTime(),
+					SourceBalances: sourceBalances,
+					CollectedCoins: collection.Coins,
+				})
+			}
+			for i, destination := range budget.CollectionDestinations() {
+				if len(budget.Destinations) > 0 {
+					destinationAcc, err := sdk.AccAddressFromBech32(destination.Address)
+					if err != nil {
+						return err
+					}
+					k.AddDestinationCollectedCoins(ctx, budget.ID, destinationAcc, collection.DestinationCoins[i])
+				}
+				event := sdk.NewEvent(
+					types.EventTypeBudgetCollected,
+					sdk.NewAttribute(types.AttributeValueName, budget.Name),
+					sdk.NewAttribute(types.AttributeValueType, budget.Type.String()),
+					sdk.NewAttribute(types.AttributeValueDestinationAddress, destination.Address),
+					sdk.NewAttribute(types.AttributeValueDestinationType, destination.Type.String()),
+					sdk.NewAttribute(types.AttributeValueSourceAddress, budget.SourceAddress),
+					sdk.NewAttribute(types.AttributeValueRate, budget.CollectionRate().String()),
+					sdk.NewAttribute(types.AttributeValueAmount, collection.DestinationCoins[i].String()),
+				)
+				if !budget.Reserve.Empty() {
+					event = event.AppendAttributes(
+						sdk.NewAttribute(types.AttributeValueReserve, types.MinCoins(budget.Reserve, sourceBalances).String()))
+				}
+				ctx.EventManager().EmitEvent(event)
 			}
+			for _, capName := range collection.Caps {
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeBudgetCapped,
+						sdk.NewAttribute(types.AttributeValueName, budget.Name),
+						sdk.NewAttribute(types.AttributeValueCap, capName),
+						sdk.NewAttribute(types.AttributeValueOriginalAmount, collection.UncappedCoins.String()),
+						sdk.NewAttribute(types.AttributeValueAmount, collection.Coins.String()),
+					),
+				)
+			}
+			if collection.Exhausted {
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeBudgetExhausted,
+						sdk.NewAttribute(types.AttributeValueName, budget.Name),
+						sdk.NewAttribute(types.AttributeValueTotalCollected, k.GetTotalCollectedCoins(ctx, budget.ID).String()),
+					),
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// Delegate delegates the bond denom balance of the delegator account of the budget to the validators of
+// the staking destination, split evenly. The rewards of the existing delegations are withdrawn first, and
+// forwarded to the rewards address of the destination if it is set, or delegated with the balance otherwise.
+// Jailed validators, including tombstoned validators which stay jailed, and validators that do not exist
+// are skipped, and the balance that is not delegated is kept in the delegator account for the next time.
+func (k Keeper) Delegate(ctx sdk.Context, budget types.Budget, destination types.BudgetDestination) error {
+	delegatorAcc := types.DelegatorAddress(budget.ID)
+	if err := k.withdrawRewards(ctx, budget, destination); err != nil {
+		return err
+	}
+
+	var validators []stakingtypes.Validator
+	for _, operator := range destination.Validators {
+		valAddr, err := sdk.ValAddressFromBech32(operator)
+		if err != nil {
+			return err
+		}
+		validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found || validator.IsJailed() {
+			continue
+		}
+		validators = append(validators, validator)
+	}
+	if len(validators) == 0 {
+		return nil
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	amount := k.bankKeeper.GetBalance(ctx, delegatorAcc, bondDenom).Amount.QuoRaw(int64(len(validators)))
+	if !amount.IsPositive() {
+		return nil
+	}
+	for _, validator := range validators {
+		// A delegation that fails, for example to a validator whose tokens were all slashed, leaves
+		// the coins in the delegator account.
+		cacheCtx, writeCache := ctx.CacheContext()
+		if _, err := k.stakingKeeper.Delegate(cacheCtx, delegatorAcc, amount, stakingtypes.Unbonded, validator, true); err != nil {
+			k.Logger(ctx).Error("failed to delegate", "name", budget.Name, "validator", validator.OperatorAddress, "error", err)
+			continue
+		}
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBudgetDelegated,
+				sdk.NewAttribute(types.AttributeValueName, budget.Name),
+				sdk.NewAttribute(types.AttributeValueValidator, validator.OperatorAddress),
+				sdk.NewAttribute(types.AttributeValueAmount, sdk.NewCoin(bondDenom, amount).String()),
+			),
+		)
+	}
+	return nil
+}
+
+// ValidateStakingBudget returns an error if the budget has a staking destination and collects a denom that is
+// not the bond denom, which cannot be delegated.
+func (k Keeper) ValidateStakingBudget(ctx sdk.Context, budget types.Budget) error {
+	if _, ok := budget.StakingDestination(); !ok {
+		return nil
+	}
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	if denom, ok := budget.SingleDenom(); !ok || denom != bondDenom {
+		return sdkerrors.Wrapf(types.ErrInvalidBudgetDestinations,
+			"budget %s with a staking destination must collect only the bond denom %s", budget.Name, bondDenom)
+	}
+	return nil
+}
+
+// withdrawRewards withdraws the rewards of the delegations of the delegator account of the budget. The rewards
+// are forwarded to the rewards address of the staking destination if it is set. Otherwise the rewards that are
+// not the bond denom, which cannot be delegated, are returned to the source of the budget.
+func (k Keeper) withdrawRewards(ctx sdk.Context, budget types.Budget, destination types.BudgetDestination) error {
+	delegatorAcc := types.DelegatorAddress(budget.ID)
 
-			inputs = append(inputs, banktypes.NewInput(sourceAcc, collectionCoins))
-			outputs = append(outputs, banktypes.NewOutput(destinationAcc, collectionCoins))
-			budgetsBySource.CollectionCoins[i] = collectionCoins
+	var rewards sdk.Coins
+	for _, delegation := range k.stakingKeeper.GetDelegatorDelegations(ctx, delegatorAcc, math.MaxUint16) {
+		withdrawn, err := k.distrKeeper.WithdrawDelegationRewards(ctx, delegatorAcc, delegation.GetValidatorAddr())
+		if err != nil {
+			return err
 		}
+		rewards = rewards.Add(withdrawn...)
+	}
+
+	recipient := destination.RewardsAddress
+	if recipient == "" {
+		bondDenom := k.stakingKeeper.BondDenom(ctx)
+		rewards = rewards.Sub(sdk.NewCoins(sdk.NewCoin(bondDenom, rewards.AmountOf(bondDenom))))
+		recipient = budget.SourceAddress
+	}
+	if rewards.IsZero() {
+		return nil
+	}
+	recipientAcc, err := k.ResolveAddress(recipient)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoins(ctx, delegatorAcc, recipientAcc, rewards)
+}
+
+// undelegateBudget withdraws the rewards of the delegations of the delegator account of the budget with a
+// staking destination, and undelegates all of them. The coins of the delegator account that are not delegated
+// are returned to the source of the budget, and it returns true if any of the delegations is unbonding,
+// including the ones undelegated before. An undelegation that fails leaves the delegation in place.
+func (k Keeper) undelegateBudget(ctx sdk.Context, budget types.Budget, destination types.BudgetDestination) (unbonding bool, err error) {
+	delegatorAcc := types.DelegatorAddress(budget.ID)
+	if err := k.withdrawRewards(ctx, budget, destination); err != nil {
+		return false, err
+	}
+	k.undelegate(ctx, budget, func(stakingtypes.Delegation) bool { return true })
+	unbonding = len(k.stakingKeeper.GetUnbondingDelegations(ctx, delegatorAcc, 1)) > 0
 
-		if err := k.bankKeeper.InputOutputCoins(ctx, inputs, outputs); err != nil {
+	if balances := k.bankKeeper.GetAllBalances(ctx, delegatorAcc); !balances.IsZero() {
+		sourceAcc, err := k.ResolveAddress(budget.SourceAddress)
+		if err != nil {
+			return unbonding, err
+		}
+		if err := k.bankKeeper.SendCoins(ctx, delegatorAcc, sourceAcc, balances); err != nil {
+			return unbonding, err
+		}
+	}
+	return unbonding, nil
+}
+
+// undelegateRemovedValidators withdraws the rewards of the delegations of the delegator account of the budget
+// by its previous staking destination, and undelegates the delegations to the validators that the staking
+// destination no longer has. The unbonded coins are delegated to its validators with the next collection.
+func (k Keeper) undelegateRemovedValidators(
+	ctx sdk.Context, budget types.Budget, prevDestination, destination types.BudgetDestination,
+) error {
+	validators := make(map[string]bool)
+	for _, operator := range destination.Validators {
+		valAddr, err := sdk.ValAddressFromBech32(operator)
+		if err != nil {
 			return err
 		}
+		validators[valAddr.String()] = true
+	}
+	if err := k.withdrawRewards(ctx, budget, prevDestination); err != nil {
+		return err
+	}
+	k.undelegate(ctx, budget, func(delegation stakingtypes.Delegation) bool {
+		return !validators[delegation.GetValidatorAddr().String()]
+	})
+	return nil
+}
+
+// undelegate undelegates the delegations of the delegator account of the budget that match the filter.
+// An undelegation that fails leaves the delegation in place.
+func (k Keeper) undelegate(ctx sdk.Context, budget types.Budget, filter func(delegation stakingtypes.Delegation) bool) {
+	delegatorAcc := types.DelegatorAddress(budget.ID)
+	for _, delegation := range k.stakingKeeper.GetDelegatorDelegations(ctx, delegatorAcc, math.MaxUint16) {
+		if !filter(delegation) {
+			continue
+		}
+		cacheCtx, writeCache := ctx.CacheContext()
+		if _, err := k.stakingKeeper.Undelegate(cacheCtx, delegatorAcc, delegation.GetValidatorAddr(), delegation.Shares); err != nil {
+			k.Logger(ctx).Error("failed to undelegate", "name", budget.Name, "validator", delegation.ValidatorAddress, "error", err)
+			continue
+		}
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
+// ReleaseUnbondedBudgets returns the coins of the delegator accounts of the budgets marked as unbonding, whose
+// delegations have all been unbonded, to the sources of the budgets. The budgets are marked as unbonding when
+// they are archived or lose their staking destination.
+func (k Keeper) ReleaseUnbondedBudgets(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.UnbondingBudgetIndexKeyPrefix)
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, types.ParseUnbondingBudgetIndexKey(iterator.Key()))
+	}
+	iterator.Close()
+
+	for _, id := range ids {
+		budget, found := k.GetBudget(ctx, id)
+		archived, archivedFound := k.GetArchivedBudget(ctx, id)
+		if archivedFound {
+			budget = archived.Budget
+		} else if !found {
+			continue
+		}
+		delegatorAcc := types.DelegatorAddress(budget.ID)
+		if len(k.stakingKeeper.GetUnbondingDelegations(ctx, delegatorAcc, 1)) > 0 {
+			continue
+		}
+		sourceAcc, err := k.ResolveAddress(budget.SourceAddress)
+		if err != nil {
+			k.Logger(ctx).Error("failed to resolve source address", "name", budget.Name, "error", err)
+			continue
+		}
+		balances := k.bankKeeper.GetAllBalances(ctx, delegatorAcc)
+		if !balances.IsZero() {
+			if err := k.bankKeeper.SendCoins(ctx, delegatorAcc, sourceAcc, balances); err != nil {
+				return err
+			}
+		}
+		if archivedFound {
+			archived.Unbonding = false
+			k.SetArchivedBudget(ctx, archived)
+		} else {
+			k.SetUnbondingBudget(ctx, id, false)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBudgetUnbonded,
+				sdk.NewAttribute(types.AttributeValueName, budget.Name),
+				sdk.NewAttribute(types.AttributeValueSourceAddress, sourceAcc.String()),
+				sdk.NewAttribute(types.AttributeValueAmount, balances.String()),
+			),
+		)
+	}
+	return nil
+}
+
+// IsUnbondingBudget returns true if the budget with the id is marked as unbonding.
+func (k Keeper) IsUnbondingBudget(ctx sdk.Context, id uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetUnbondingBudgetIndexKey(id))
+}
+
+// SetUnbondingBudget marks the budget with the id as unbonding or not, so that the coins of its delegator account
+// are returned to its source by ReleaseUnbondedBudgets once its delegations are unbonded.
+func (k Keeper) SetUnbondingBudget(ctx sdk.Context, id uint64, unbonding bool) {
+	store := ctx.KVStore(k.storeKey)
+	if unbonding {
+		store.Set(types.GetUnbondingBudgetIndexKey(id), []byte{})
+	} else {
+		store.Delete(types.GetUnbondingBudgetIndexKey(id))
+	}
+}
+
+// GetLastBudgetID returns the last id assigned to a budget.
+func (k Keeper) GetLastBudgetID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastBudgetIDKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastBudgetID sets the last id assigned to a budget.
+func (k Keeper) SetLastBudgetID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastBudgetIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+// GetBudget returns the budget with the id.
+func (k Keeper) GetBudget(ctx sdk.Context, id uint64) (budget types.Budget, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBudgetKey(id))
+	if bz == nil {
+		return budget, false
+	}
+	k.cdc.MustUnmarshal(bz, &budget)
+	return budget, true
+}
+
+// GetBudgetByName returns the budget with the name.
+func (k Keeper) GetBudgetByName(ctx sdk.Context, name string) (budget types.Budget, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBudgetByNameIndexKey(name))
+	if bz == nil {
+		return budget, false
+	}
+	return k.GetBudget(ctx, sdk.BigEndianToUint64(bz))
+}
+
+// GetAllBudgets returns all the budgets in order of id.
+func (k Keeper) GetAllBudgets(ctx sdk.Context) []types.Budget {
+	budgets := []types.Budget{}
+	k.IterateAllBudgets(ctx, func(budget types.Budget) (stop bool) {
+		budgets = append(budgets, budget)
+		return false
+	})
+	return budgets
+}
+
+// IterateAllBudgets iterates over all the stored budgets in order of id and performs a callback function.
+// Stops iteration when callback returns true.
+func (k Keeper) IterateAllBudgets(ctx sdk.Context, cb func(budget types.Budget) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.BudgetKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var budget types.Budget
+		k.cdc.MustUnmarshal(iterator.Value(), &budget)
+		if cb(budget) {
+			break
+		}
+	}
+}
+
+// SetBudget sets the budget by its id, and indexes it by its name and its resolved source and destination addresses.
+// The indexes of the previous budget are deleted if the budget is updated. A budget with an IBC transfer destination
+// is also indexed by its IBC escrow address, see setIBCEscrowIndex.
+func (k Keeper) SetBudget(ctx sdk.Context, budget types.Budget) {
+	store := ctx.KVStore(k.storeKey)
+	if prev, found := k.GetBudget(ctx, budget.ID); found {
+		if prev.Name != budget.Name {
+			store.Delete(types.GetBudgetByNameIndexKey(prev.Name))
+		}
+		k.deleteBudgetAddressIndexes(ctx, prev)
+	}
+	store.Set(types.GetBudgetKey(budget.ID), k.cdc.MustMarshal(&budget))
+	store.Set(types.GetBudgetByNameIndexKey(budget.Name), sdk.Uint64ToBigEndian(budget.ID))
+	sourceAccs, destinationAccs := k.budgetIndexAddresses(budget)
+	for _, sourceAcc := range sourceAccs {
+		store.Set(types.GetBudgetBySourceIndexKey(sourceAcc, budget.ID), []byte{})
+	}
+	for _, destinationAcc := range destinationAccs {
+		store.Set(types.GetBudgetByDestinationIndexKey(destinationAcc, budget.ID), []byte{})
+	}
+	k.setIBCEscrowIndex(ctx, budget)
+}
+
+// setIBCEscrowIndex indexes the budget by its IBC escrow address if it has an IBC transfer destination.
+// The index is never deleted, since the packets sent by the escrow account may be acknowledged after
+// the budget loses its IBC transfer destination or is archived.
+func (k Keeper) setIBCEscrowIndex(ctx sdk.Context, budget types.Budget) {
+	if _, ok := budget.IBCTransferDestination(); ok {
+		store := ctx.KVStore(k.storeKey)
+		store.Set(types.GetBudgetByIBCEscrowIndexKey(types.IBCEscrowAddress(budget.ID)), sdk.Uint64ToBigEndian(budget.ID))
+	}
+}
+
+// GetBudgetIDByIBCEscrow returns the id of the budget with the IBC escrow address.
+func (k Keeper) GetBudgetIDByIBCEscrow(ctx sdk.Context, escrowAcc sdk.AccAddress) (id uint64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBudgetByIBCEscrowIndexKey(escrowAcc))
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// deleteBudgetAddressIndexes deletes the indexes of the budget by its source and destination addresses.
+func (k Keeper) deleteBudgetAddressIndexes(ctx sdk.Context, budget types.Budget) {
+	store := ctx.KVStore(k.storeKey)
+	sourceAccs, destinationAccs := k.budgetIndexAddresses(budget)
+	for _, sourceAcc := range sourceAccs {
+		store.Delete(types.GetBudgetBySourceIndexKey(sourceAcc, budget.ID))
+	}
+	for _, destinationAcc := range destinationAccs {
+		store.Delete(types.GetBudgetByDestinationIndexKey(destinationAcc, budget.ID))
+	}
+}
+
+// budgetIndexAddresses returns the resolved source and destination addresses the budget is indexed by.
+// The addresses are resolved as by ResolveBudget, and the addresses that cannot be resolved are not indexed.
+func (k Keeper) budgetIndexAddresses(budget types.Budget) (sourceAccs, destinationAccs []sdk.AccAddress) {
+	if resolved, err := k.ResolveBudget(budget); err == nil {
+		budget = resolved
+	}
+	if sourceAcc, err := k.ResolveAddress(budget.SourceAddress); err == nil {
+		sourceAccs = append(sourceAccs, sourceAcc)
+	}
+	for _, destination := range budget.CollectionDestinations() {
+		if destinationAcc, err := k.ResolveAddress(destination.Address); err == nil {
+			destinationAccs = append(destinationAccs, destinationAcc)
+		}
+	}
+	return sourceAccs, destinationAccs
+}
+
+// GetBudgetIDsBySource returns the ids of the budgets with the resolved source address in order of id.
+func (k Keeper) GetBudgetIDsBySource(ctx sdk.Context, sourceAcc sdk.AccAddress) []uint64 {
+	return k.getIndexedBudgetIDs(ctx, types.GetBudgetsBySourceIndexKey(sourceAcc))
+}
+
+// GetBudgetIDsByDestination returns the ids of the budgets with the resolved destination address in order of id.
+func (k Keeper) GetBudgetIDsByDestination(ctx sdk.Context, destinationAcc sdk.AccAddress) []uint64 {
+	return k.getIndexedBudgetIDs(ctx, types.GetBudgetsByDestinationIndexKey(destinationAcc))
+}
+
+func (k Keeper) getIndexedBudgetIDs(ctx sdk.Context, prefix []byte) []uint64 {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		_, id := types.ParseBudgetByAddressIndexKey(iterator.Key())
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// ReindexBudgets sets the indexes of all the budgets by their resolved source and destination addresses.
+func (k Keeper) ReindexBudgets(ctx sdk.Context) {
+	for _, budget := range k.GetAllBudgets(ctx) {
+		k.SetBudget(ctx, budget)
+	}
+}
+
+// AddBudget assigns a new id to the budget and sets it. It returns the budget with the id.
+func (k Keeper) AddBudget(ctx sdk.Context, budget types.Budget) types.Budget {
+	budget.ID = k.GetLastBudgetID(ctx) + 1
+	k.SetLastBudgetID(ctx, budget.ID)
+	k.SetBudget(ctx, budget)
+	return budget
+}
+
+// UpdateBudget sets the updated budget. The delegations of a budget that loses its staking destination are
+// undelegated as by ArchiveBudget, and the budget is marked as unbonding. The delegations to the validators that
+// a staking destination no longer has are undelegated. The balance of the IBC escrow account of a budget that
+// loses its IBC transfer destination is returned to its source.
+func (k Keeper) UpdateBudget(ctx sdk.Context, budget types.Budget) {
+	if prev, found := k.GetBudget(ctx, budget.ID); found {
+		prevStaking, hadStaking := prev.StakingDestination()
+		staking, hasStaking := budget.StakingDestination()
+		switch {
+		case hadStaking && !hasStaking:
+			unbonding, err := k.undelegateBudget(ctx, budget, prevStaking)
+			if err != nil {
+				k.Logger(ctx).Error("failed to undelegate budget", "name", budget.Name, "error", err)
+			}
+			k.SetUnbondingBudget(ctx, budget.ID, unbonding)
+		case hadStaking && hasStaking:
+			if err := k.undelegateRemovedValidators(ctx, budget, prevStaking, staking); err != nil {
+				k.Logger(ctx).Error("failed to undelegate removed validators", "name", budget.Name, "error", err)
+			}
+		case hasStaking:
+			// The unbonded coins of the staking destination it had before are delegated again.
+			k.SetUnbondingBudget(ctx, budget.ID, false)
+		}
+
+		prevDestination, hadIBCTransfer := prev.IBCTransferDestination()
+		if _, ok := budget.IBCTransferDestination(); hadIBCTransfer && !ok {
+			if err := k.refundIBCEscrow(ctx, budget, *prevDestination.IBCTransfer, types.IBCRefundReasonDestinationRemoved); err != nil {
+				k.Logger(ctx).Error("failed to refund ibc escrow", "name", budget.Name, "error", err)
+			}
+		}
+	}
+	k.SetBudget(ctx, budget)
+}
 
-		for i, budget := range budgetsBySource.Budgets {
-			k.AddTotalCollectedCoins(ctx, budget.Name, budgetsBySource.CollectionCoins[i])
-			ctx.EventManager().EmitEvents(sdk.Events{
+// DeleteBudget deletes the budget with the id and its indexes.
+// The records of the budget are kept, and its id is never reused. Use ArchiveBudget to move
+// the records of the budget to the archive.
+func (k Keeper) DeleteBudget(ctx sdk.Context, id uint64) {
+	budget, found := k.GetBudget(ctx, id)
+	if !found {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBudgetKey(id))
+	store.Delete(types.GetBudgetByNameIndexKey(budget.Name))
+	k.deleteBudgetAddressIndexes(ctx, budget)
+}
+
+// ArchiveBudget moves the budget with the id and its records to the archive with the status, and emits
+// an event that the budget has ended. The collection records of the budget are kept until they are pruned.
+// The delegations of a budget with a staking destination are undelegated, and the coins of its delegator
+// account are returned to its source, the unbonding coins once they are unbonded. The balance of the IBC
+// escrow account of a budget with an IBC transfer destination is returned to its source.
+func (k Keeper) ArchiveBudget(ctx sdk.Context, id uint64, status types.BudgetStatus) {
+	budget, found := k.GetBudget(ctx, id)
+	if !found {
+		return
+	}
+	// A budget that lost its staking destination may still be unbonding.
+	unbonding := k.IsUnbondingBudget(ctx, id)
+	if destination, ok := budget.StakingDestination(); ok {
+		undelegated, err := k.undelegateBudget(ctx, budget, destination)
+		if err != nil {
+			k.Logger(ctx).Error("failed to undelegate budget", "name", budget.Name, "error", err)
+		}
+		unbonding = unbonding || undelegated
+	}
+	if destination, ok := budget.IBCTransferDestination(); ok {
+		if err := k.refundIBCEscrow(ctx, budget, *destination.IBCTransfer, types.IBCRefundReasonArchived); err != nil {
+			k.Logger(ctx).Error("failed to refund ibc escrow", "name", budget.Name, "error", err)
+		}
+	}
+	k.SetArchivedBudget(ctx, types.ArchivedBudget{
+		Budget:                    budget,
+		Status:                    status,
+		ArchivedHeight:            ctx.BlockHeight(),
+		ArchivedTime:              ctx.BlockTime(),
+		TotalCollectedCoins:       k.GetTotalCollectedCoins(ctx, id),
+		DestinationCollectedCoins: k.GetAllDestinationCollectedCoins(ctx, id),
+		Unbonding:                 unbonding,
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetDestinationCollectedCoinsByBudgetKey(id))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	keys = append(keys,
+		types.GetTotalCollectedCoinsKey(id),
+		types.GetNextPeriodKey(id),
+		types.GetRemainderKey(id),
+		types.GetLastCollectedHeightKey(id),
+		types.GetBudgetStatusKey(id),
+	)
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	k.DeleteBudget(ctx, id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBudgetEnded,
+			sdk.NewAttribute(types.AttributeValueName, budget.Name),
+			sdk.NewAttribute(types.AttributeValueStatus, status.String()),
+		),
+	)
+}
+
+// UpdateBudgetStatuses updates the lifecycle statuses of the budgets at the block time and height.
+// An event is emitted for each budget that becomes active, and the budgets that have expired are archived.
+func (k Keeper) UpdateBudgetStatuses(ctx sdk.Context) {
+	for _, budget := range k.GetAllBudgets(ctx) {
+		status := budget.Status(ctx.BlockTime(), ctx.BlockHeight())
+		if status == k.GetBudgetStatus(ctx, budget.ID) {
+			continue
+		}
+		switch status {
+		case types.BudgetStatusExpired:
+			k.ArchiveBudget(ctx, budget.ID, types.BudgetStatusExpired)
+			continue
+		case types.BudgetStatusActive:
+			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
-					types.EventTypeBudgetCollected,
+					types.EventTypeBudgetStarted,
 					sdk.NewAttribute(types.AttributeValueName, budget.Name),
-					sdk.NewAttribute(types.AttributeValueDestinationAddress, budget.DestinationAddress),
-					sdk.NewAttribute(types.AttributeValueSourceAddress, budget.SourceAddress),
-					sdk.NewAttribute(types.AttributeValueRate, budget.Rate.String()),
-					sdk.NewAttribute(types.AttributeValueAmount, budgetsBySource.CollectionCoins[i].String()),
 				),
-			})
+			)
 		}
+		k.SetBudgetStatus(ctx, budget.ID, status)
 	}
-	return nil
+}
+
+// GetBudgetStatus returns the last updated lifecycle status of a budget.
+// It returns BudgetStatusUnspecified if the status of the budget has not been updated.
+func (k Keeper) GetBudgetStatus(ctx sdk.Context, budgetID uint64) types.BudgetStatus {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetBudgetStatusKey(budgetID))
+	if bz == nil {
+		return types.BudgetStatusUnspecified
+	}
+	return types.BudgetStatus(sdk.BigEndianToUint64(bz))
+}
+
+// SetBudgetStatus sets the lifecycle status of a budget.
+func (k Keeper) SetBudgetStatus(ctx sdk.Context, budgetID uint64, status types.BudgetStatus) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetBudgetStatusKey(budgetID), sdk.Uint64ToBigEndian(uint64(status)))
+}
+
+// IterateAllBudgetStatuses iterates over all the stored budget statuses and performs a callback function.
+// Stops iteration when callback returns true.
+func (k Keeper) IterateAllBudgetStatuses(ctx sdk.Context, cb func(budgetID uint64, status types.BudgetStatus) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.BudgetStatusKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.ParseBudgetStatusKey(iterator.Key()), types.BudgetStatus(sdk.BigEndianToUint64(iterator.Value()))) {
+			break
+		}
+	}
+}
+
+// GetArchivedBudget returns the archived budget with the id.
+func (k Keeper) GetArchivedBudget(ctx sdk.Context, id uint64) (archived types.ArchivedBudget, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetArchivedBudgetKey(id))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &archived)
+	return archived, true
+}
+
+// GetAllArchivedBudgets returns all the archived budgets in order of their ids.
+func (k Keeper) GetAllArchivedBudgets(ctx sdk.Context) []types.ArchivedBudget {
+	archivedBudgets := []types.ArchivedBudget{}
+	k.IterateAllArchivedBudgets(ctx, func(archived types.ArchivedBudget) (stop bool) {
+		archivedBudgets = append(archivedBudgets, archived)
+		return false
+	})
+	return archivedBudgets
+}
+
+// IterateAllArchivedBudgets iterates over all the archived budgets and performs a callback function.
+// Stops iteration when callback returns true.
+func (k Keeper) IterateAllArchivedBudgets(ctx sdk.Context, cb func(archived types.ArchivedBudget) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ArchivedBudgetKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var archived types.ArchivedBudget
+		k.cdc.MustUnmarshal(iterator.Value(), &archived)
+		if cb(archived) {
+			break
+		}
+	}
+}
+
+// SetArchivedBudget sets the archived budget by the id of its budget, and indexes it if its delegations are unbonding
+// and by its IBC escrow address if it has an IBC transfer destination.
+func (k Keeper) SetArchivedBudget(ctx sdk.Context, archived types.ArchivedBudget) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetArchivedBudgetKey(archived.Budget.ID), k.cdc.MustMarshal(&archived))
+	k.setIBCEscrowIndex(ctx, archived.Budget)
+	k.SetUnbondingBudget(ctx, archived.Budget.ID, archived.Unbonding)
 }
 
 // GetTotalCollectedCoins returns total collected coins for a budget.
-func (k Keeper) GetTotalCollectedCoins(ctx sdk.Context, budgetName string) sdk.Coins {
+func (k Keeper) GetTotalCollectedCoins(ctx sdk.Context, budgetID uint64) sdk.Coins {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetTotalCollectedCoinsKey(budgetName))
+	bz := store.Get(types.GetTotalCollectedCoinsKey(budgetID))
 	if bz == nil {
 		return nil
 	}
@@ -95,7 +1011,7 @@ func (k Keeper) IterateAllTotalCollectedCoins(ctx sdk.Context, cb func(record ty
 		var record types.BudgetRecord
 		var collectedCoins types.TotalCollectedCoins
 		k.cdc.MustUnmarshal(iterator.Value(), &collectedCoins)
-		record.Name = types.ParseTotalCollectedCoinsKey(iterator.Key())
+		record.BudgetID = types.ParseTotalCollectedCoinsKey(iterator.Key())
 		record.TotalCollectedCoins = collectedCoins.TotalCollectedCoins
 		if cb(record) {
 			break
@@ -104,16 +1020,288 @@ func (k Keeper) IterateAllTotalCollectedCoins(ctx sdk.Context, cb func(record ty
 }
 
 // SetTotalCollectedCoins sets total collected coins for a budget.
-func (k Keeper) SetTotalCollectedCoins(ctx sdk.Context, budgetName string, amount sdk.Coins) {
+func (k Keeper) SetTotalCollectedCoins(ctx sdk.Context, budgetID uint64, amount sdk.Coins) {
 	store := ctx.KVStore(k.storeKey)
 	collectedCoins := types.TotalCollectedCoins{TotalCollectedCoins: amount}
 	bz := k.cdc.MustMarshal(&collectedCoins)
-	store.Set(types.GetTotalCollectedCoinsKey(budgetName), bz)
+	store.Set(types.GetTotalCollectedCoinsKey(budgetID), bz)
 }
 
 // AddTotalCollectedCoins increases total collected coins for a budget.
-func (k Keeper) AddTotalCollectedCoins(ctx sdk.Context, budgetName string, amount sdk.Coins) {
-	collectedCoins := k.GetTotalCollectedCoins(ctx, budgetName)
+func (k Keeper) AddTotalCollectedCoins(ctx sdk.Context, budgetID uint64, amount sdk.Coins) {
+	collectedCoins := k.GetTotalCollectedCoins(ctx, budgetID)
+	collectedCoins = collectedCoins.Add(amount...)
+	k.SetTotalCollectedCoins(ctx, budgetID, collectedCoins)
+}
+
+// GetTotalBurnedCoins returns the total coins burned by all budgets.
+func (k Keeper) GetTotalBurnedCoins(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TotalBurnedCoinsKey)
+	if bz == nil {
+		return nil
+	}
+	var burnedCoins types.TotalBurnedCoins
+	k.cdc.MustUnmarshal(bz, &burnedCoins)
+	return burnedCoins.TotalBurnedCoins
+}
+
+// SetTotalBurnedCoins sets the total coins burned by all budgets.
+func (k Keeper) SetTotalBurnedCoins(ctx sdk.Context, amount sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&types.TotalBurnedCoins{TotalBurnedCoins: amount})
+	store.Set(types.TotalBurnedCoinsKey, bz)
+}
+
+// AddTotalBurnedCoins increases the total coins burned by all budgets.
+func (k Keeper) AddTotalBurnedCoins(ctx sdk.Context, amount sdk.Coins) {
+	k.SetTotalBurnedCoins(ctx, k.GetTotalBurnedCoins(ctx).Add(amount...))
+}
+
+// GetDestinationCollectedCoins returns total collected coins for a destination of a budget.
+func (k Keeper) GetDestinationCollectedCoins(ctx sdk.Context, budgetID uint64, destinationAcc sdk.AccAddress) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDestinationCollectedCoinsKey(budgetID, destinationAcc))
+	if bz == nil {
+		return nil
+	}
+	var collectedCoins types.TotalCollectedCoins
+	k.cdc.MustUnmarshal(bz, &collectedCoins)
+	return collectedCoins.TotalCollectedCoins
+}
+
+// GetAllDestinationCollectedCoins returns total collected coins for all the destinations of a budget.
+func (k Keeper) GetAllDestinationCollectedCoins(ctx sdk.Context, budgetID uint64) []types.DestinationCollectedCoins {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetDestinationCollectedCoinsByBudgetKey(budgetID))
+
+	defer iterator.Close()
+	var records []types.DestinationCollectedCoins
+	for ; iterator.Valid(); iterator.Next() {
+		var collectedCoins types.TotalCollectedCoins
+		k.cdc.MustUnmarshal(iterator.Value(), &collectedCoins)
+		_, destinationAcc := types.ParseDestinationCollectedCoinsKey(iterator.Key())
+		records = append(records, types.DestinationCollectedCoins{
+			DestinationAddress:  destinationAcc.String(),
+			TotalCollectedCoins: collectedCoins.TotalCollectedCoins,
+		})
+	}
+	return records
+}
+
+// SetDestinationCollectedCoins sets total collected coins for a destination of a budget.
+func (k Keeper) SetDestinationCollectedCoins(ctx sdk.Context, budgetID uint64, destinationAcc sdk.AccAddress, amount sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	collectedCoins := types.TotalCollectedCoins{TotalCollectedCoins: amount}
+	bz := k.cdc.MustMarshal(&collectedCoins)
+	store.Set(types.GetDestinationCollectedCoinsKey(budgetID, destinationAcc), bz)
+}
+
+// AddDestinationCollectedCoins increases total collected coins for a destination of a budget.
+func (k Keeper) AddDestinationCollectedCoins(ctx sdk.Context, budgetID uint64, destinationAcc sdk.AccAddress, amount sdk.Coins) {
+	collectedCoins := k.GetDestinationCollectedCoins(ctx, budgetID, destinationAcc)
 	collectedCoins = collectedCoins.Add(amount...)
-	k.SetTotalCollectedCoins(ctx, budgetName, collectedCoins)
+	k.SetDestinationCollectedCoins(ctx, budgetID, destinationAcc, collectedCoins)
+}
+
+// GetNextPeriod returns the next recurrence period of a budget.
+// The periods before it have been collected.
+func (k Keeper) GetNextPeriod(ctx sdk.Context, budgetID uint64) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetNextPeriodKey(budgetID))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetNextPeriod sets the next recurrence period of a budget.
+func (k Keeper) SetNextPeriod(ctx sdk.Context, budgetID uint64, period uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetNextPeriodKey(budgetID), sdk.Uint64ToBigEndian(period))
+}
+
+// IterateAllNextPeriods iterates over all the stored next periods and performs a callback function.
+// Stops iteration when callback returns true.
+func (k Keeper) IterateAllNextPeriods(ctx sdk.Context, cb func(budgetID uint64, period uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.NextPeriodKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.ParseNextPeriodKey(iterator.Key()), sdk.BigEndianToUint64(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetLastCollectedHeight returns the block height at which a budget last collected.
+// It returns zero if the budget has never collected.
+func (k Keeper) GetLastCollectedHeight(ctx sdk.Context, budgetID uint64) int64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetLastCollectedHeightKey(budgetID))
+	if bz == nil {
+		return 0
+	}
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
+// SetLastCollectedHeight sets the block height at which a budget last collected.
+func (k Keeper) SetLastCollectedHeight(ctx sdk.Context, budgetID uint64, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetLastCollectedHeightKey(budgetID), sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// IterateAllLastCollectedHeights iterates over all the stored last collected heights and performs a callback function.
+// Stops iteration when callback returns true.
+func (k Keeper) IterateAllLastCollectedHeights(ctx sdk.Context, cb func(budgetID uint64, height int64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.LastCollectedHeightKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(types.ParseLastCollectedHeightKey(iterator.Key()), int64(sdk.BigEndianToUint64(iterator.Value()))) {
+			break
+		}
+	}
+}
+
+// GetLastEpochTime returns the last epoch boundary of the duration epoch mode.
+// It returns the zero time if no epoch has started.
+func (k Keeper) GetLastEpochTime(ctx sdk.Context) time.Time {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastEpochTimeKey)
+	if bz == nil {
+		return time.Time{}
+	}
+	t, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// SetLastEpochTime sets the last epoch boundary of the duration epoch mode.
+func (k Keeper) SetLastEpochTime(ctx sdk.Context, t time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastEpochTimeKey, sdk.FormatTimeBytes(t))
+}
+
+// GetRemainder returns the fractional remainder of the coins of a budget.
+func (k Keeper) GetRemainder(ctx sdk.Context, budgetID uint64) sdk.DecCoins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRemainderKey(budgetID))
+	if bz == nil {
+		return nil
+	}
+	var remainder types.Remainder
+	k.cdc.MustUnmarshal(bz, &remainder)
+	return remainder.Remainder
+}
+
+// SetRemainder sets the fractional remainder of the coins of a budget.
+// The remainder is deleted if it is empty.
+func (k Keeper) SetRemainder(ctx sdk.Context, budgetID uint64, remainder sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+	if remainder.Empty() {
+		store.Delete(types.GetRemainderKey(budgetID))
+		return
+	}
+	bz := k.cdc.MustMarshal(&types.Remainder{Remainder: remainder})
+	store.Set(types.GetRemainderKey(budgetID), bz)
+}
+
+// IterateAllRemainders iterates over all the stored remainders and performs a callback function.
+// Stops iteration when callback returns true.
+func (k Keeper) IterateAllRemainders(ctx sdk.Context, cb func(budgetID uint64, remainder sdk.DecCoins) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RemainderKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var remainder types.Remainder
+		k.cdc.MustUnmarshal(iterator.Value(), &remainder)
+		if cb(types.ParseRemainderKey(iterator.Key()), remainder.Remainder) {
+			break
+		}
+	}
+}
+
+// GetCollectionRecord returns the collection record of a budget at a height.
+func (k Keeper) GetCollectionRecord(ctx sdk.Context, budgetID uint64, height int64) (record types.CollectionRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCollectionRecordKey(budgetID, height))
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshal(bz, &record)
+	return record, true
+}
+
+// GetAllCollectionRecords returns all the collection records in order of budget id and height.
+func (k Keeper) GetAllCollectionRecords(ctx sdk.Context) []types.CollectionRecord {
+	records := []types.CollectionRecord{}
+	k.IterateAllCollectionRecords(ctx, func(record types.CollectionRecord) (stop bool) {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// IterateAllCollectionRecords iterates over all the stored collection records and performs a callback function.
+// Stops iteration when callback returns true.
+func (k Keeper) IterateAllCollectionRecords(ctx sdk.Context, cb func(record types.CollectionRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.CollectionRecordKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.CollectionRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// SetCollectionRecord sets the collection record of a budget along with its index by height.
+func (k Keeper) SetCollectionRecord(ctx sdk.Context, record types.CollectionRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&record)
+	store.Set(types.GetCollectionRecordKey(record.BudgetID, record.Height), bz)
+	store.Set(types.GetCollectionRecordByHeightIndexKey(record.Height, record.BudgetID), []byte{})
+}
+
+// DeleteCollectionRecord deletes the collection record of a budget at a height along with its index.
+func (k Keeper) DeleteCollectionRecord(ctx sdk.Context, budgetID uint64, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCollectionRecordKey(budgetID, height))
+	store.Delete(types.GetCollectionRecordByHeightIndexKey(height, budgetID))
+}
+
+// PruneCollectionRecords deletes the collection records that are older than the history retention blocks.
+// All the collection records are deleted if the history retention blocks is zero.
+func (k Keeper) PruneCollectionRecords(ctx sdk.Context) {
+	retention := k.GetParams(ctx).HistoryRetentionBlocks
+	if ctx.BlockHeight() <= 0 || retention >= uint64(ctx.BlockHeight()) {
+		return
+	}
+	pruneHeight := ctx.BlockHeight() - int64(retention)
+
+	// The index keys are collected first, since the store must not be written while it is iterated.
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		types.CollectionRecordByHeightIndexKeyPrefix,
+		types.GetCollectionRecordsByHeightIndexKey(pruneHeight+1),
+	)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		height, budgetID := types.ParseCollectionRecordByHeightIndexKey(key)
+		k.DeleteCollectionRecord(ctx, budgetID, height)
+	}
 }
@@ -1,13 +1,16 @@
 package keeper_test
 
 import (
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	paramscutils "github.com/cosmos/cosmos-sdk/x/params/client/utils"
-	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
-	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 
 	"github.com/tendermint/budget/app"
 	"github.com/tendermint/budget/x/budget/types"
@@ -76,6 +79,58 @@ func (suite *KeeperTestSuite) TestCollectBudgets() {
 			},
 			false,
 		},
+		{
+			"fixed amount budget with rate budget case",
+			[]types.Budget{
+				suite.budgets[0],
+				{
+					Name:               "budget-fixed",
+					Type:               types.BudgetTypeFixedAmount,
+					Amount:             mustParseCoinsNormalized("300000000denom1,600000000denom2"),
+					SourceAddress:      suite.sourceAddrs[0].String(),
+					DestinationAddress: suite.destinationAddrs[1].String(),
+					StartTime:          types.MustParseRFC3339("0001-01-01T00:00:00Z"),
+					EndTime:            types.MustParseRFC3339("9999-12-31T00:00:00Z"),
+				},
+			},
+			types.DefaultEpochBlocks,
+			[]sdk.AccAddress{
+				suite.destinationAddrs[0],
+				suite.destinationAddrs[1],
+				suite.sourceAddrs[0],
+			},
+			[]sdk.Coins{
+				mustParseCoinsNormalized("500000000denom1,500000000denom2,500000000denom3,500000000stake"),
+				mustParseCoinsNormalized("300000000denom1,500000000denom2"),
+				mustParseCoinsNormalized("200000000denom1,500000000denom3,500000000stake"),
+			},
+			false,
+		},
+		{
+			"denom rates and allowed denoms case",
+			[]types.Budget{
+				{
+					Name:               "budget-denoms",
+					Rate:               sdk.MustNewDecFromStr("0.5"),
+					DenomRates:         []types.DenomRate{{Denom: denom2, Rate: sdk.MustNewDecFromStr("0.1")}},
+					AllowedDenoms:      []string{denom1, denom2},
+					SourceAddress:      suite.sourceAddrs[0].String(),
+					DestinationAddress: suite.destinationAddrs[0].String(),
+					StartTime:          types.MustParseRFC3339("0001-01-01T00:00:00Z"),
+					EndTime:            types.MustParseRFC3339("9999-12-31T00:00:00Z"),
+				},
+			},
+			types.DefaultEpochBlocks,
+			[]sdk.AccAddress{
+				suite.destinationAddrs[0],
+				suite.sourceAddrs[0],
+			},
+			[]sdk.Coins{
+				mustParseCoinsNormalized("500000000denom1,100000000denom2"),
+				mustParseCoinsNormalized("500000000denom1,900000000denom2,1000000000denom3,1000000000stake"),
+			},
+			false,
+		},
 		{
 			"none budgets case",
 			nil,
@@ -157,10 +212,11 @@ func (suite *KeeperTestSuite) TestCollectBudgets() {
 	} {
 		suite.Run(tc.name, func() {
 			suite.SetupTest()
+			suite.ctx = suite.ctx.WithBlockTime(types.MustParseRFC3339("2021-08-31T00:00:00Z"))
 			params := suite.keeper.GetParams(suite.ctx)
-			params.Budgets = tc.budgets
 			params.EpochBlocks = tc.epochBlocks
 			suite.keeper.SetParams(suite.ctx, params)
+			suite.setBudgets(tc.budgets...)
 
 			err := suite.keeper.CollectBudgets(suite.ctx)
 			if tc.expectErr {
@@ -190,7 +246,7 @@ func (suite *KeeperTestSuite) TestBudgetChangeSituation() {
 
 	for _, tc := range []struct {
 		name                   string
-		proposal               *proposal.ParameterChangeProposal
+		proposal               string
 		budgetCount            int
 		collectibleBudgetCount int
 		govTime                time.Time
@@ -201,10 +257,10 @@ func (suite *KeeperTestSuite) TestBudgetChangeSituation() {
 	}{
 		{
 			"add budget 1",
-			testProposal(proposal.ParamChange{
-				Subspace: types.ModuleName,
-				Key:      string(types.KeyBudgets),
-				Value: `[
+			`{
+				"title": "title",
+				"description": "description",
+				"add_budgets": [
 					{
 					"name": "gravity-dex-farming-1",
 					"rate": "0.500000000000000000",
@@ -213,8 +269,9 @@ func (suite *KeeperTestSuite) TestBudgetChangeSituation() {
 					"start_time": "2021-09-01T00:00:00Z",
 					"end_time": "2031-09-30T00:00:00Z"
 					}
-				]`,
-			}),
+				],
+				"delete_budget_ids": []
+			}`,
 			1,
 			0,
 			types.MustParseRFC3339("2021-08-01T00:00:00Z"),
@@ -230,18 +287,10 @@ func (suite *KeeperTestSuite) TestBudgetChangeSituation() {
 		},
 		{
 			"add budget 2",
-			testProposal(proposal.ParamChange{
-				Subspace: types.ModuleName,
-				Key:      string(types.KeyBudgets),
-				Value: `[
-					{
-					"name": "gravity-dex-farming-1",
-					"rate": "0.500000000000000000",
-					"source_address": "cosmos10wy60v3zuks7rkwnqxs3e878zqfhus6m98l77q6rppz40kxwgllsruc0az",
-					"destination_address": "cosmos1qceyjmnrl6hapntjq3z25vn38nh68u7yxvufs2thptxvqm7huxeqj7zyrq",
-					"start_time": "2021-09-01T00:00:00Z",
-					"end_time": "2031-09-30T00:00:00Z"
-					},
+			`{
+				"title": "title",
+				"description": "description",
+				"add_budgets": [
 					{
 					"name": "gravity-dex-farming-2",
 					"rate": "0.500000000000000000",
@@ -250,8 +299,9 @@ func (suite *KeeperTestSuite) TestBudgetChangeSituation() {
 					"start_time": "2021-09-01T00:00:00Z",
 					"end_time": "2021-09-30T00:00:00Z"
 					}
-				]`,
-			}),
+				],
+				"delete_budget_ids": []
+			}`,
 			2,
 			2,
 			types.MustParseRFC3339("2021-09-03T00:00:00Z"),
@@ -267,26 +317,10 @@ func (suite *KeeperTestSuite) TestBudgetChangeSituation() {
 		},
 		{
 			"add budget 3 with invalid total rate case 1",
-			testProposal(proposal.ParamChange{
-				Subspace: types.ModuleName,
-				Key:      string(types.KeyBudgets),
-				Value: `[
-					{
-					"name": "gravity-dex-farming-1",
-					"rate": "0.500000000000000000",
-					"source_address": "cosmos10wy60v3zuks7rkwnqxs3e878zqfhus6m98l77q6rppz40kxwgllsruc0az",
-					"destination_address": "cosmos1qceyjmnrl6hapntjq3z25vn38nh68u7yxvufs2thptxvqm7huxeqj7zyrq",
-					"start_time": "2021-09-01T00:00:00Z",
-					"end_time": "2031-09-30T00:00:00Z"
-					},
-					{
-					"name": "gravity-dex-farming-2",
-					"rate": "0.500000000000000000",
-					"source_address": "cosmos10wy60v3zuks7rkwnqxs3e878zqfhus6m98l77q6rppz40kxwgllsruc0az",
-					"destination_address": "cosmos1czyx0dj2yd26gv3stpxzv23ddy8pld4j6p90a683mdcg8vzy72jqa8tm6p",
-					"start_time": "2021-09-01T00:00:00Z",
-					"end_time": "2021-09-30T00:00:00Z"
-					},
+			`{
+				"title": "title",
+				"description": "description",
+				"add_budgets": [
 					{
 					"name": "gravity-dex-farming-3",
 					"rate": "0.500000000000000000",
@@ -295,10 +329,11 @@ func (suite *KeeperTestSuite) TestBudgetChangeSituation() {
 					"start_time": "2021-09-30T00:00:00Z",
 					"end_time": "2021-10-10T00:00:00Z"
 					}
-				]`,
-			}),
-			2, // left last budgets of 2nd tc
-			1, // left last budgets of 2nd tc
+				],
+				"delete_budget_ids": []
+			}`,
+			2, // left budgets of 2nd tc
+			1, // left budgets of 2nd tc
 			types.MustParseRFC3339("2021-09-29T00:00:00Z"),
 			types.MustParseRFC3339("2021-09-30T00:00:00Z"),
 			types.ErrInvalidTotalBudgetRate,
@@ -312,26 +347,10 @@ func (suite *KeeperTestSuite) TestBudgetChangeSituation() {
 		},
 		{
 			"add budget 3 with invalid total rate case 2",
-			testProposal(proposal.ParamChange{
-				Subspace: types.ModuleName,
-				Key:      string(types.KeyBudgets),
-				Value: `[
-					{
-					"name": "gravity-dex-farming-1",
-					"rate": "0.500000000000000000",
-					"source_address": "cosmos10wy60v3zuks7rkwnqxs3e878zqfhus6m98l77q6rppz40kxwgllsruc0az",
-					"destination_address": "cosmos1qceyjmnrl6hapntjq3z25vn38nh68u7yxvufs2thptxvqm7huxeqj7zyrq",
-					"start_time": "2021-09-01T00:00:00Z",
-					"end_time": "2031-09-30T00:00:00Z"
-					},
-					{
-					"name": "gravity-dex-farming-2",
-					"rate": "0.500000000000000000",
-					"source_address": "cosmos10wy60v3zuks7rkwnqxs3e878zqfhus6m98l77q6rppz40kxwgllsruc0az",
-					"destination_address": "cosmos1czyx0dj2yd26gv3stpxzv23ddy8pld4j6p90a683mdcg8vzy72jqa8tm6p",
-					"start_time": "2021-09-01T00:00:00Z",
-					"end_time": "2021-09-30T00:00:00Z"
-					},
+			`{
+				"title": "title",
+				"description": "description",
+				"add_budgets": [
 					{
 					"name": "gravity-dex-farming-3",
 					"rate": "0.500000000000000000",
@@ -340,10 +359,11 @@ func (suite *KeeperTestSuite) TestBudgetChangeSituation() {
 					"start_time": "2021-09-30T00:00:00Z",
 					"end_time": "2021-10-10T00:00:00Z"
 					}
-				]`,
-			}),
-			2, // left last budgets of 2nd tc
-			1, // left last budgets of 2nd tc
+				],
+				"delete_budget_ids": []
+			}`,
+			2, // left budgets of 2nd tc
+			1, // left budgets of 2nd tc
 			types.MustParseRFC3339("2021-10-01T00:00:00Z"),
 			types.MustParseRFC3339("2021-10-01T00:00:00Z"),
 			types.ErrInvalidTotalBudgetRate,
@@ -356,19 +376,11 @@ func (suite *KeeperTestSuite) TestBudgetChangeSituation() {
 			},
 		},
 		{
-			"add budget 3",
-			testProposal(proposal.ParamChange{
-				Subspace: types.ModuleName,
-				Key:      string(types.KeyBudgets),
-				Value: `[
-					{
-					"name": "gravity-dex-farming-1",
-					"rate": "0.500000000000000000",
-					"source_address": "cosmos10wy60v3zuks7rkwnqxs3e878zqfhus6m98l77q6rppz40kxwgllsruc0az",
-					"destination_address": "cosmos1qceyjmnrl6hapntjq3z25vn38nh68u7yxvufs2thptxvqm7huxeqj7zyrq",
-					"start_time": "2021-09-01T00:00:00Z",
-					"end_time": "2031-09-30T00:00:00Z"
-					},
+			"replace budget 2 with budget 3",
+			`{
+				"title": "title",
+				"description": "description",
+				"add_budgets": [
 					{
 					"name": "gravity-dex-farming-3",
 					"rate": "0.500000000000000000",
@@ -377,8 +389,9 @@ func (suite *KeeperTestSuite) TestBudgetChangeSituation() {
 					"start_time": "2021-09-30T00:00:00Z",
 					"end_time": "2021-10-10T00:00:00Z"
 					}
-				]`,
-			}),
+				],
+				"delete_budget_ids": ["2"]
+			}`,
 			2,
 			2,
 			types.MustParseRFC3339("2021-10-01T00:00:00Z"),
@@ -393,19 +406,11 @@ func (suite *KeeperTestSuite) TestBudgetChangeSituation() {
 			},
 		},
 		{
-			"add budget 4 without date range overlap",
-			testProposal(proposal.ParamChange{
-				Subspace: types.ModuleName,
-				Key:      string(types.KeyBudgets),
-				Value: `[
-					{
-					"name": "gravity-dex-farming-1",
-					"rate": "0.500000000000000000",
-					"source_address": "cosmos10wy60v3zuks7rkwnqxs3e878zqfhus6m98l77q6rppz40kxwgllsruc0az",
-					"destination_address": "cosmos1qceyjmnrl6hapntjq3z25vn38nh68u7yxvufs2thptxvqm7huxeqj7zyrq",
-					"start_time": "2021-09-01T00:00:00Z",
-					"end_time": "2031-09-30T00:00:00Z"
-					},
+			"replace budget 3 with budget 4 without date range overlap",
+			`{
+				"title": "title",
+				"description": "description",
+				"add_budgets": [
 					{
 					"name": "gravity-dex-farming-4",
 					"rate": "1.000000000000000000",
@@ -414,8 +419,9 @@ func (suite *KeeperTestSuite) TestBudgetChangeSituation() {
 					"start_time": "2031-09-30T00:00:01Z",
 					"end_time": "2031-12-10T00:00:00Z"
 					}
-				]`,
-			}),
+				],
+				"delete_budget_ids": ["3"]
+			}`,
 			2,
 			1,
 			types.MustParseRFC3339("2021-09-29T00:00:00Z"),
@@ -431,11 +437,12 @@ func (suite *KeeperTestSuite) TestBudgetChangeSituation() {
 		},
 		{
 			"remove all budgets",
-			testProposal(proposal.ParamChange{
-				Subspace: types.ModuleName,
-				Key:      string(types.KeyBudgets),
-				Value:    `[]`,
-			}),
+			`{
+				"title": "title",
+				"description": "description",
+				"add_budgets": [],
+				"delete_budget_ids": ["1", "4"]
+			}`,
 			0,
 			0,
 			types.MustParseRFC3339("2021-10-25T00:00:00Z"),
@@ -451,19 +458,14 @@ func (suite *KeeperTestSuite) TestBudgetChangeSituation() {
 		},
 	} {
 		suite.Run(tc.name, func() {
-			proposalJson := paramscutils.ParamChangeProposalJSON{}
-			bz, err := tc.proposal.Marshal()
-			suite.Require().NoError(err)
-			err = encCfg.Amino.Unmarshal(bz, &proposalJson)
-			suite.Require().NoError(err)
-			proposal := paramproposal.NewParameterChangeProposal(
-				proposalJson.Title, proposalJson.Description, proposalJson.Changes.ToParamChanges(),
-			)
+			var proposal types.BudgetProposal
+			err := encCfg.Marshaler.UnmarshalJSON([]byte(tc.proposal), &proposal)
 			suite.Require().NoError(err)
+			suite.Require().NoError(proposal.ValidateBasic())
 
-			// endblock gov paramchange ->(new block)-> beginblock budget -> mempool -> endblock gov paramchange ->(new block)-> ...
+			// endblock gov budget proposal ->(new block)-> beginblock budget -> mempool -> endblock gov budget proposal ->(new block)-> ...
 			suite.ctx = suite.ctx.WithBlockTime(tc.govTime)
-			err = suite.govHandler(suite.ctx, proposal)
+			err = suite.govHandler(suite.ctx, &proposal)
 			if tc.expErr != nil {
 				suite.Require().Error(err)
 			} else {
@@ -475,14 +477,14 @@ func (suite *KeeperTestSuite) TestBudgetChangeSituation() {
 			suite.ctx = suite.ctx.WithBlockHeight(int64(height))
 			suite.ctx = suite.ctx.WithBlockTime(tc.nextBlockTime)
 
-			params := suite.keeper.GetParams(suite.ctx)
-			suite.Require().Len(params.Budgets, tc.budgetCount)
-			for _, budget := range params.Budgets {
+			allBudgets := suite.keeper.GetAllBudgets(suite.ctx)
+			suite.Require().Len(allBudgets, tc.budgetCount)
+			for _, budget := range allBudgets {
 				err := budget.Validate()
 				suite.Require().NoError(err)
 			}
 
-			budgets := types.CollectibleBudgets(params.Budgets, suite.ctx.BlockTime())
+			budgets := types.CollectibleBudgets(allBudgets, suite.ctx.BlockTime(), suite.ctx.BlockHeight())
 			suite.Require().Len(budgets, tc.collectibleBudgetCount)
 
 			// BeginBlocker - inflation or mint on budgetSource
@@ -503,20 +505,222 @@ func (suite *KeeperTestSuite) TestBudgetChangeSituation() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestBudgetStore() {
+	budget1 := suite.keeper.AddBudget(suite.ctx, suite.budgets[0])
+	budget2 := suite.keeper.AddBudget(suite.ctx, suite.budgets[1])
+	suite.Require().Equal(uint64(1), budget1.ID)
+	suite.Require().Equal(uint64(2), budget2.ID)
+	suite.Require().Equal(uint64(2), suite.keeper.GetLastBudgetID(suite.ctx))
+	suite.Require().Equal([]types.Budget{budget1, budget2}, suite.keeper.GetAllBudgets(suite.ctx))
+
+	budget, found := suite.keeper.GetBudgetByName(suite.ctx, budget2.Name)
+	suite.Require().True(found)
+	suite.Require().Equal(budget2, budget)
+
+	// renaming a budget keeps its id and moves its name index
+	budget2.Name = "budget2-renamed"
+	suite.keeper.SetBudget(suite.ctx, budget2)
+	_, found = suite.keeper.GetBudgetByName(suite.ctx, suite.budgets[1].Name)
+	suite.Require().False(found)
+	budget, found = suite.keeper.GetBudgetByName(suite.ctx, budget2.Name)
+	suite.Require().True(found)
+	suite.Require().Equal(budget2.ID, budget.ID)
+
+	// the id of a deleted budget is not reused, and its records are kept
+	suite.keeper.SetTotalCollectedCoins(suite.ctx, budget1.ID, mustParseCoinsNormalized("100denom1"))
+	suite.keeper.DeleteBudget(suite.ctx, budget1.ID)
+	_, found = suite.keeper.GetBudget(suite.ctx, budget1.ID)
+	suite.Require().False(found)
+	_, found = suite.keeper.GetBudgetByName(suite.ctx, budget1.Name)
+	suite.Require().False(found)
+	suite.Require().Equal(mustParseCoinsNormalized("100denom1"), suite.keeper.GetTotalCollectedCoins(suite.ctx, budget1.ID))
+
+	budget3 := suite.keeper.AddBudget(suite.ctx, suite.budgets[0])
+	suite.Require().Equal(uint64(3), budget3.ID)
+	suite.Require().True(suite.keeper.GetTotalCollectedCoins(suite.ctx, budget3.ID).Empty())
+}
+
+func (suite *KeeperTestSuite) TestBudgetAddressIndexes() {
+	budgets := suite.setBudgets(suite.budgets[0], suite.budgets[1], suite.budgets[4])
+	suite.Require().Equal([]uint64{1, 2}, suite.keeper.GetBudgetIDsBySource(suite.ctx, suite.sourceAddrs[0]))
+	suite.Require().Equal([]uint64{1, 3}, suite.keeper.GetBudgetIDsByDestination(suite.ctx, suite.destinationAddrs[0]))
+	suite.Require().Equal([]uint64{2}, suite.keeper.GetBudgetIDsByDestination(suite.ctx, suite.destinationAddrs[1]))
+
+	// updating a budget moves its indexes
+	budgets[1].DestinationAddress = suite.destinationAddrs[0].String()
+	suite.keeper.SetBudget(suite.ctx, budgets[1])
+	suite.Require().Equal([]uint64{1, 2, 3}, suite.keeper.GetBudgetIDsByDestination(suite.ctx, suite.destinationAddrs[0]))
+	suite.Require().Empty(suite.keeper.GetBudgetIDsByDestination(suite.ctx, suite.destinationAddrs[1]))
+
+	// deleting a budget deletes its indexes
+	suite.keeper.DeleteBudget(suite.ctx, budgets[0].ID)
+	suite.Require().Equal([]uint64{2}, suite.keeper.GetBudgetIDsBySource(suite.ctx, suite.sourceAddrs[0]))
+	suite.Require().Equal([]uint64{2, 3}, suite.keeper.GetBudgetIDsByDestination(suite.ctx, suite.destinationAddrs[0]))
+
+	// the budgets are indexed by the addresses that their address references resolve to, and the
+	// references that cannot be resolved are not indexed
+	budget := suite.budgets[5]
+	budget.DestinationAddress = "module:" + authtypes.FeeCollectorName
+	budget = suite.keeper.AddBudget(suite.ctx, budget)
+	feeCollectorAcc := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	suite.Require().Equal([]uint64{budget.ID}, suite.keeper.GetBudgetIDsByDestination(suite.ctx, feeCollectorAcc))
+	budget.DestinationAddress = "module:unknown"
+	suite.keeper.SetBudget(suite.ctx, budget)
+	suite.Require().Empty(suite.keeper.GetBudgetIDsByDestination(suite.ctx, feeCollectorAcc))
+	suite.Require().Equal([]uint64{budgets[2].ID, budget.ID}, suite.keeper.GetBudgetIDsBySource(suite.ctx, suite.sourceAddrs[3]))
+}
+
+func (suite *KeeperTestSuite) TestBudgetIBCEscrowIndex() {
+	budgets := suite.setBudgets(suite.budgets[0], suite.budgets[1])
+	_, found := suite.keeper.GetBudgetIDByIBCEscrow(suite.ctx, types.IBCEscrowAddress(budgets[0].ID))
+	suite.Require().False(found)
+
+	transfer := types.IBCTransfer{SourcePort: "transfer", SourceChannel: "channel-0", Receiver: suite.destinationAddrs[0].String(), Timeout: time.Hour}
+	budgets[1].DestinationAddress = ""
+	budgets[1].Destinations = []types.BudgetDestination{{Weight: sdk.OneDec(), Type: types.DestinationTypeIBCTransfer, IBCTransfer: &transfer}}
+	suite.keeper.SetBudget(suite.ctx, budgets[1])
+	id, found := suite.keeper.GetBudgetIDByIBCEscrow(suite.ctx, types.IBCEscrowAddress(budgets[1].ID))
+	suite.Require().True(found)
+	suite.Require().Equal(budgets[1].ID, id)
+
+	// the index is kept after the budget loses its ibc transfer destination and is archived
+	budgets[1].Destinations = []types.BudgetDestination{{Weight: sdk.OneDec(), Type: types.DestinationTypeBurn}}
+	suite.keeper.SetBudget(suite.ctx, budgets[1])
+	suite.keeper.ArchiveBudget(suite.ctx, budgets[1].ID, types.BudgetStatusRemoved)
+	id, found = suite.keeper.GetBudgetIDByIBCEscrow(suite.ctx, types.IBCEscrowAddress(budgets[1].ID))
+	suite.Require().True(found)
+	suite.Require().Equal(budgets[1].ID, id)
+}
+
+func (suite *KeeperTestSuite) TestUpdateBudgetStatuses() {
+	budgets := suite.setBudgets(suite.budgets[0], suite.budgets[3], suite.budgets[6])
+	suite.keeper.SetTotalCollectedCoins(suite.ctx, budgets[1].ID, mustParseCoinsNormalized("100denom1"))
+	suite.keeper.SetLastCollectedHeight(suite.ctx, budgets[1].ID, 10)
+
+	events := func(ctx sdk.Context) (started, ended []string) {
+		for _, event := range ctx.EventManager().Events() {
+			switch event.Type {
+			case types.EventTypeBudgetStarted:
+				started = append(started, string(event.Attributes[0].Value))
+			case types.EventTypeBudgetEnded:
+				ended = append(ended, string(event.Attributes[0].Value)+":"+string(event.Attributes[1].Value))
+			}
+		}
+		return
+	}
+
+	ctx := suite.ctx.WithBlockTime(types.MustParseRFC3339("2021-08-31T00:00:00Z")).WithEventManager(sdk.NewEventManager())
+	suite.keeper.UpdateBudgetStatuses(ctx)
+	started, ended := events(ctx)
+	suite.Require().Equal([]string{budgets[0].Name}, started)
+	suite.Require().Equal([]string{budgets[1].Name + ":BUDGET_STATUS_EXPIRED"}, ended)
+	suite.Require().Equal(types.BudgetStatusActive, suite.keeper.GetBudgetStatus(ctx, budgets[0].ID))
+	suite.Require().Equal(types.BudgetStatusUpcoming, suite.keeper.GetBudgetStatus(ctx, budgets[2].ID))
+
+	// the expired budget is moved to the archive with its records
+	_, found := suite.keeper.GetBudget(ctx, budgets[1].ID)
+	suite.Require().False(found)
+	archived, found := suite.keeper.GetArchivedBudget(ctx, budgets[1].ID)
+	suite.Require().True(found)
+	suite.Require().Equal(budgets[1], archived.Budget)
+	suite.Require().Equal(types.BudgetStatusExpired, archived.Status)
+	suite.Require().Equal(mustParseCoinsNormalized("100denom1"), archived.TotalCollectedCoins)
+	suite.Require().True(suite.keeper.GetTotalCollectedCoins(ctx, budgets[1].ID).Empty())
+	suite.Require().Zero(suite.keeper.GetLastCollectedHeight(ctx, budgets[1].ID))
+
+	// no events are emitted if the statuses do not change
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	suite.keeper.UpdateBudgetStatuses(ctx)
+	started, ended = events(ctx)
+	suite.Require().Empty(started)
+	suite.Require().Empty(ended)
+
+	ctx = ctx.WithBlockTime(types.MustParseRFC3339("2021-09-01T00:00:00Z"))
+	suite.keeper.UpdateBudgetStatuses(ctx)
+	started, _ = events(ctx)
+	suite.Require().Equal([]string{budgets[2].Name}, started)
+
+	genState := suite.keeper.ExportGenesis(ctx)
+	suite.Require().NoError(types.ValidateGenesis(*genState))
+	suite.Require().Equal([]types.ArchivedBudget{archived}, genState.ArchivedBudgets)
+	suite.keeper.InitGenesis(ctx, *genState)
+	suite.Require().Equal(genState, suite.keeper.ExportGenesis(ctx))
+}
+
+func (suite *KeeperTestSuite) TestArchiveBudgetStaking() {
+	stakingHelper := teststaking.NewHelper(suite.T(), suite.ctx, suite.app.StakingKeeper)
+	valAddr := app.ConvertAddrsToValAddrs(suite.addrs[:1])[0]
+	stakingHelper.CreateValidator(valAddr, app.CreateTestPubKeys(1)[0], sdk.NewInt(1_000_000), true)
+
+	budget := suite.budgets[0]
+	budget.Rate = sdk.NewDecWithPrec(1, 1)
+	budget.DestinationAddress = ""
+	budget.AllowedDenoms = []string{sdk.DefaultBondDenom}
+	budget.Destinations = []types.BudgetDestination{{
+		Weight:     sdk.OneDec(),
+		Type:       types.DestinationTypeStaking,
+		Validators: []string{valAddr.String()},
+	}}
+	budget = suite.setBudgets(budget)[0]
+
+	sourceBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.sourceAddrs[0])
+	err := suite.keeper.CollectBudgets(suite.ctx)
+	suite.Require().NoError(err)
+	delegatorAcc := types.DelegatorAddress(budget.ID)
+	_, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, delegatorAcc, valAddr)
+	suite.Require().True(found)
+
+	// the delegations of the archived budget are undelegated
+	suite.keeper.ArchiveBudget(suite.ctx, budget.ID, types.BudgetStatusRemoved)
+	_, found = suite.app.StakingKeeper.GetDelegation(suite.ctx, delegatorAcc, valAddr)
+	suite.Require().False(found)
+	ubd, found := suite.app.StakingKeeper.GetUnbondingDelegation(suite.ctx, delegatorAcc, valAddr)
+	suite.Require().True(found)
+	archived, found := suite.keeper.GetArchivedBudget(suite.ctx, budget.ID)
+	suite.Require().True(found)
+	suite.Require().True(archived.Unbonding)
+
+	// the coins are returned to the source once they are unbonded
+	suite.Require().NoError(suite.keeper.ReleaseUnbondedBudgets(suite.ctx))
+	archived, _ = suite.keeper.GetArchivedBudget(suite.ctx, budget.ID)
+	suite.Require().True(archived.Unbonding)
+
+	ctx := suite.ctx.WithBlockTime(ubd.Entries[0].CompletionTime).WithEventManager(sdk.NewEventManager())
+	_, err = suite.app.StakingKeeper.CompleteUnbonding(ctx, delegatorAcc, valAddr)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.keeper.ReleaseUnbondedBudgets(ctx))
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(ctx, delegatorAcc).Empty())
+	suite.Require().True(coinsEq(sourceBalances, suite.app.BankKeeper.GetAllBalances(ctx, suite.sourceAddrs[0])))
+	archived, _ = suite.keeper.GetArchivedBudget(ctx, budget.ID)
+	suite.Require().False(archived.Unbonding)
+	released := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeBudgetUnbonded {
+			released++
+		}
+	}
+	suite.Require().Equal(1, released)
+
+	// the budget is released only once
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().NoError(suite.keeper.ReleaseUnbondedBudgets(ctx))
+	suite.Require().Empty(ctx.EventManager().Events())
+}
+
 func (suite *KeeperTestSuite) TestGetSetTotalCollectedCoins() {
-	collectedCoins := suite.keeper.GetTotalCollectedCoins(suite.ctx, "budget1")
+	collectedCoins := suite.keeper.GetTotalCollectedCoins(suite.ctx, 1)
 	suite.Require().Nil(collectedCoins)
 
-	suite.keeper.SetTotalCollectedCoins(suite.ctx, "budget1", sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
-	collectedCoins = suite.keeper.GetTotalCollectedCoins(suite.ctx, "budget1")
+	suite.keeper.SetTotalCollectedCoins(suite.ctx, 1, sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	collectedCoins = suite.keeper.GetTotalCollectedCoins(suite.ctx, 1)
 	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)), collectedCoins))
 
-	suite.keeper.AddTotalCollectedCoins(suite.ctx, "budget1", sdk.NewCoins(sdk.NewInt64Coin(denom2, 1000000)))
-	collectedCoins = suite.keeper.GetTotalCollectedCoins(suite.ctx, "budget1")
+	suite.keeper.AddTotalCollectedCoins(suite.ctx, 1, sdk.NewCoins(sdk.NewInt64Coin(denom2, 1000000)))
+	collectedCoins = suite.keeper.GetTotalCollectedCoins(suite.ctx, 1)
 	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000), sdk.NewInt64Coin(denom2, 1000000)), collectedCoins))
 
-	suite.keeper.AddTotalCollectedCoins(suite.ctx, "budget2", sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
-	collectedCoins = suite.keeper.GetTotalCollectedCoins(suite.ctx, "budget2")
+	suite.keeper.AddTotalCollectedCoins(suite.ctx, 2, sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)))
+	collectedCoins = suite.keeper.GetTotalCollectedCoins(suite.ctx, 2)
 	suite.Require().True(coinsEq(sdk.NewCoins(sdk.NewInt64Coin(denom1, 1000000)), collectedCoins))
 }
 
@@ -526,24 +730,797 @@ func (suite *KeeperTestSuite) TestTotalCollectedCoins() {
 		Rate:               sdk.NewDecWithPrec(5, 2), // 5%
 		SourceAddress:      suite.sourceAddrs[0].String(),
 		DestinationAddress: suite.destinationAddrs[0].String(),
-		StartTime:          types.MustParseRFC3339("0000-01-01T00:00:00Z"),
+		StartTime:          types.MustParseRFC3339("0001-01-01T00:00:00Z"),
 		EndTime:            types.MustParseRFC3339("9999-12-31T00:00:00Z"),
 	}
 
-	params := suite.keeper.GetParams(suite.ctx)
-	params.Budgets = []types.Budget{budget}
-	suite.keeper.SetParams(suite.ctx, params)
+	budget = suite.setBudgets(budget)[0]
 
 	balance := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.sourceAddrs[0])
 	expectedCoins, _ := sdk.NewDecCoinsFromCoins(balance...).MulDec(sdk.NewDecWithPrec(5, 2)).TruncateDecimal()
 
-	collectedCoins := suite.keeper.GetTotalCollectedCoins(suite.ctx, "budget1")
+	collectedCoins := suite.keeper.GetTotalCollectedCoins(suite.ctx, 1)
 	suite.Require().Equal(sdk.Coins(nil), collectedCoins)
 
 	suite.ctx = suite.ctx.WithBlockTime(types.MustParseRFC3339("2021-08-31T00:00:00Z"))
 	err := suite.keeper.CollectBudgets(suite.ctx)
 	suite.Require().NoError(err)
 
-	collectedCoins = suite.keeper.GetTotalCollectedCoins(suite.ctx, "budget1")
+	collectedCoins = suite.keeper.GetTotalCollectedCoins(suite.ctx, 1)
 	suite.Require().True(coinsEq(expectedCoins, collectedCoins))
 }
+
+func (suite *KeeperTestSuite) TestLifetimeCap() {
+	budget := suite.budgets[0]
+	budget.LifetimeCap = mustParseCoinsNormalized("600000000denom1")
+
+	budget = suite.setBudgets(budget)[0]
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	err := suite.keeper.CollectBudgets(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(
+		mustParseCoinsNormalized("500000000denom1,500000000denom2,500000000denom3,500000000stake"),
+		suite.keeper.GetTotalCollectedCoins(suite.ctx, budget.ID)))
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	err = suite.keeper.CollectBudgets(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(
+		mustParseCoinsNormalized("600000000denom1,750000000denom2,750000000denom3,750000000stake"),
+		suite.keeper.GetTotalCollectedCoins(suite.ctx, budget.ID)))
+
+	var eventTypes []string
+	for _, event := range suite.ctx.EventManager().Events() {
+		if strings.HasPrefix(event.Type, types.ModuleName) {
+			eventTypes = append(eventTypes, event.Type)
+		}
+	}
+	suite.Require().Equal([]string{types.EventTypeBudgetCollected, types.EventTypeBudgetCapped, types.EventTypeBudgetExhausted}, eventTypes)
+
+	// the exhausted budget does not collect anymore
+	err = suite.keeper.CollectBudgets(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(
+		mustParseCoinsNormalized("600000000denom1,750000000denom2,750000000denom3,750000000stake"),
+		suite.keeper.GetTotalCollectedCoins(suite.ctx, budget.ID)))
+
+	resp, err := suite.querier.Budgets(sdk.WrapSDKContext(suite.ctx), &types.QueryBudgetsRequest{Name: budget.Name})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Budgets, 1)
+	suite.Require().True(resp.Budgets[0].Exhausted)
+}
+
+func (suite *KeeperTestSuite) TestCollectBudgetsDestinations() {
+	budget := suite.budgets[0]
+	budget.DestinationAddress = ""
+	budget.Destinations = []types.BudgetDestination{
+		{Address: suite.destinationAddrs[0].String(), Weight: sdk.NewDec(3)},
+		{Address: suite.destinationAddrs[1].String(), Weight: sdk.NewDec(1)},
+	}
+
+	budget = suite.setBudgets(budget)[0]
+
+	balances0 := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.destinationAddrs[0])
+	balances1 := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.destinationAddrs[1])
+
+	err := suite.keeper.CollectBudgets(suite.ctx)
+	suite.Require().NoError(err)
+
+	collected := suite.keeper.GetTotalCollectedCoins(suite.ctx, budget.ID)
+	collected0 := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.destinationAddrs[0]).Sub(balances0)
+	collected1 := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.destinationAddrs[1]).Sub(balances1)
+	suite.Require().True(coinsEq(collected, collected0.Add(collected1...)))
+	suite.Require().True(coinsEq(
+		mustParseCoinsNormalized("375000000denom1,375000000denom2,375000000denom3,375000000stake"), collected0))
+	suite.Require().True(coinsEq(
+		mustParseCoinsNormalized("125000000denom1,125000000denom2,125000000denom3,125000000stake"), collected1))
+	suite.Require().True(coinsEq(collected1,
+		suite.keeper.GetDestinationCollectedCoins(suite.ctx, budget.ID, suite.destinationAddrs[1])))
+
+	resp, err := suite.querier.Budgets(sdk.WrapSDKContext(suite.ctx), &types.QueryBudgetsRequest{
+		DestinationAddress: suite.destinationAddrs[1].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Budgets, 1)
+	suite.Require().Len(resp.Budgets[0].DestinationCollectedCoins, 2)
+
+	genState := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().Len(genState.BudgetRecords, 1)
+	suite.Require().Len(genState.BudgetRecords[0].DestinationCollectedCoins, 2)
+}
+
+func (suite *KeeperTestSuite) TestCollectBudgetsSchedule() {
+	budget := suite.budgets[0]
+	budget.Schedule = &types.RateSchedule{
+		Type: types.ScheduleTypeSteps,
+		Points: []types.SchedulePoint{
+			{Time: types.MustParseRFC3339("2021-01-01T00:00:00Z"), Factor: sdk.OneDec()},
+			{Time: types.MustParseRFC3339("2021-06-01T00:00:00Z"), Factor: sdk.MustNewDecFromStr("0.5")},
+		},
+	}
+
+	budget = suite.setBudgets(budget)[0]
+
+	suite.ctx = suite.ctx.WithBlockTime(types.MustParseRFC3339("2021-05-01T00:00:00Z"))
+	err := suite.keeper.CollectBudgets(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(
+		mustParseCoinsNormalized("500000000denom1,500000000denom2,500000000denom3,500000000stake"),
+		suite.keeper.GetTotalCollectedCoins(suite.ctx, budget.ID)))
+
+	suite.ctx = suite.ctx.WithBlockTime(types.MustParseRFC3339("2021-07-01T00:00:00Z"))
+	err = suite.keeper.CollectBudgets(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(
+		mustParseCoinsNormalized("625000000denom1,625000000denom2,625000000denom3,625000000stake"),
+		suite.keeper.GetTotalCollectedCoins(suite.ctx, budget.ID)))
+}
+
+func (suite *KeeperTestSuite) TestCollectBudgetsRecurrence() {
+	budget := types.Budget{
+		Name:               "monthly-grant",
+		Type:               types.BudgetTypeFixedAmount,
+		Amount:             mustParseCoinsNormalized("1000denom1"),
+		SourceAddress:      suite.sourceAddrs[0].String(),
+		DestinationAddress: suite.destinationAddrs[0].String(),
+		StartTime:          types.MustParseRFC3339("2021-01-01T00:00:00Z"),
+		EndTime:            types.MustParseRFC3339("2022-01-01T00:00:00Z"),
+		Recurrence:         &types.Recurrence{Type: types.RecurrenceTypeMonthly},
+	}
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.EpochBlocks = 100
+	suite.keeper.SetParams(suite.ctx, params)
+	budget = suite.setBudgets(budget)[0]
+
+	for i, tc := range []struct {
+		blockTime time.Time
+		collected sdk.Coins
+	}{
+		{types.MustParseRFC3339("2021-01-01T00:00:00Z"), mustParseCoinsNormalized("1000denom1")},
+		{types.MustParseRFC3339("2021-01-15T00:00:00Z"), mustParseCoinsNormalized("1000denom1")},
+		{types.MustParseRFC3339("2021-02-01T00:00:05Z"), mustParseCoinsNormalized("2000denom1")},
+		{types.MustParseRFC3339("2021-02-01T00:00:10Z"), mustParseCoinsNormalized("2000denom1")},
+		{types.MustParseRFC3339("2021-04-02T00:00:00Z"), mustParseCoinsNormalized("3000denom1")},
+	} {
+		suite.ctx = suite.ctx.WithBlockTime(tc.blockTime).WithBlockHeight(int64(i + 1))
+		err := suite.keeper.CollectBudgets(suite.ctx)
+		suite.Require().NoError(err)
+		suite.Require().True(coinsEq(tc.collected, suite.keeper.GetTotalCollectedCoins(suite.ctx, budget.ID)))
+	}
+	suite.Require().Equal(uint64(4), suite.keeper.GetNextPeriod(suite.ctx, budget.ID))
+
+	genState := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().Len(genState.BudgetRecords, 1)
+	suite.Require().Equal(uint64(4), genState.BudgetRecords[0].NextPeriod)
+}
+
+func (suite *KeeperTestSuite) TestCollectBudgetsEpoch() {
+	everyBlock := types.Budget{
+		Name:               "every-block",
+		Type:               types.BudgetTypeFixedAmount,
+		Amount:             mustParseCoinsNormalized("1000denom1"),
+		SourceAddress:      suite.sourceAddrs[0].String(),
+		DestinationAddress: suite.destinationAddrs[0].String(),
+		StartTime:          types.MustParseRFC3339("0001-01-01T00:00:00Z"),
+		EndTime:            types.MustParseRFC3339("9999-12-31T00:00:00Z"),
+	}
+	weekly := everyBlock
+	weekly.Name = "weekly"
+	weekly.DestinationAddress = suite.destinationAddrs[1].String()
+	weekly.EpochBlocks = 5
+	weekly.EpochOffset = 2
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.EpochBlocks = 1
+	suite.keeper.SetParams(suite.ctx, params)
+	budgets := suite.setBudgets(everyBlock, weekly)
+	everyBlock, weekly = budgets[0], budgets[1]
+
+	for height := int64(1); height <= 10; height++ {
+		suite.ctx = suite.ctx.WithBlockHeight(height)
+		err := suite.keeper.CollectBudgets(suite.ctx)
+		suite.Require().NoError(err)
+	}
+	suite.Require().True(coinsEq(mustParseCoinsNormalized("10000denom1"), suite.keeper.GetTotalCollectedCoins(suite.ctx, everyBlock.ID)))
+	suite.Require().True(coinsEq(mustParseCoinsNormalized("2000denom1"), suite.keeper.GetTotalCollectedCoins(suite.ctx, weekly.ID)))
+	suite.Require().Equal(int64(7), suite.keeper.GetLastCollectedHeight(suite.ctx, weekly.ID))
+
+	// the budget does not collect at height 11, which is in the phase of its new epoch length,
+	// since the new epoch length has not passed since its last collection
+	weekly.EpochBlocks = 6
+	weekly.EpochOffset = 5
+	suite.keeper.SetBudget(suite.ctx, weekly)
+	for height := int64(11); height <= 17; height++ {
+		suite.ctx = suite.ctx.WithBlockHeight(height)
+		err := suite.keeper.CollectBudgets(suite.ctx)
+		suite.Require().NoError(err)
+		if height == 11 {
+			suite.Require().Equal(int64(7), suite.keeper.GetLastCollectedHeight(suite.ctx, weekly.ID))
+		}
+	}
+	suite.Require().True(coinsEq(mustParseCoinsNormalized("3000denom1"), suite.keeper.GetTotalCollectedCoins(suite.ctx, weekly.ID)))
+	suite.Require().Equal(int64(17), suite.keeper.GetLastCollectedHeight(suite.ctx, weekly.ID))
+
+	// the same block does not collect twice
+	err := suite.keeper.CollectBudgets(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(mustParseCoinsNormalized("17000denom1"), suite.keeper.GetTotalCollectedCoins(suite.ctx, everyBlock.ID)))
+
+	genState := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().Len(genState.BudgetRecords, 2)
+	for _, record := range genState.BudgetRecords {
+		suite.Require().Equal(int64(17), record.LastCollectedHeight)
+	}
+}
+
+func (suite *KeeperTestSuite) TestCollectBudgetsEpochDuration() {
+	daily := types.Budget{
+		Name:               "daily",
+		Type:               types.BudgetTypeFixedAmount,
+		Amount:             mustParseCoinsNormalized("1000denom1"),
+		SourceAddress:      suite.sourceAddrs[0].String(),
+		DestinationAddress: suite.destinationAddrs[0].String(),
+		StartTime:          types.MustParseRFC3339("0001-01-01T00:00:00Z"),
+		EndTime:            types.MustParseRFC3339("9999-12-31T00:00:00Z"),
+	}
+	everyBlock := daily
+	everyBlock.Name = "every-block"
+	everyBlock.DestinationAddress = suite.destinationAddrs[1].String()
+	everyBlock.EpochBlocks = 1
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.EpochMode = types.EpochModeDuration
+	params.EpochDuration = 24 * time.Hour
+	suite.keeper.SetParams(suite.ctx, params)
+	budgets := suite.setBudgets(daily, everyBlock)
+	daily, everyBlock = budgets[0], budgets[1]
+
+	for i, tc := range []struct {
+		blockTime time.Time
+		collected sdk.Coins
+	}{
+		{types.MustParseRFC3339("2021-08-01T06:00:00Z"), mustParseCoinsNormalized("1000denom1")},
+		{types.MustParseRFC3339("2021-08-01T18:00:00Z"), mustParseCoinsNormalized("1000denom1")},
+		{types.MustParseRFC3339("2021-08-02T06:00:10Z"), mustParseCoinsNormalized("2000denom1")},
+		{types.MustParseRFC3339("2021-08-02T07:00:00Z"), mustParseCoinsNormalized("2000denom1")},
+		{types.MustParseRFC3339("2021-08-05T05:00:00Z"), mustParseCoinsNormalized("3000denom1")},
+		{types.MustParseRFC3339("2021-08-05T05:30:00Z"), mustParseCoinsNormalized("3000denom1")},
+	} {
+		suite.ctx = suite.ctx.WithBlockTime(tc.blockTime).WithBlockHeight(int64(i + 1))
+		err := suite.keeper.CollectBudgets(suite.ctx)
+		suite.Require().NoError(err)
+		suite.Require().True(coinsEq(tc.collected, suite.keeper.GetTotalCollectedCoins(suite.ctx, daily.ID)))
+	}
+	suite.Require().True(coinsEq(mustParseCoinsNormalized("6000denom1"), suite.keeper.GetTotalCollectedCoins(suite.ctx, everyBlock.ID)))
+	suite.Require().Equal(types.MustParseRFC3339("2021-08-04T06:00:00Z"), suite.keeper.GetLastEpochTime(suite.ctx))
+
+	genState := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().Equal(types.MustParseRFC3339("2021-08-04T06:00:00Z"), genState.LastEpochTime)
+}
+
+func (suite *KeeperTestSuite) TestCollectBudgetsSequential() {
+	budget1 := suite.budgets[0]
+	budget2 := suite.budgets[1]
+	budget2.Priority = 1
+
+	budgets := suite.setBudgets(budget1, budget2, suite.budgets[2])
+	budget1, budget2 = budgets[0], budgets[1]
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.SourceProcessingModes = []types.SourceProcessingMode{
+		{SourceAddress: budget1.SourceAddress, Mode: types.ProcessingModeSequential},
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	err := suite.keeper.CollectBudgets(suite.ctx)
+	suite.Require().NoError(err)
+
+	// budget2 has the higher priority and takes half of the source balance first
+	suite.Require().True(coinsEq(
+		mustParseCoinsNormalized("500000000denom1,500000000denom2,500000000denom3,500000000stake"),
+		suite.keeper.GetTotalCollectedCoins(suite.ctx, budget2.ID)))
+	suite.Require().True(coinsEq(
+		mustParseCoinsNormalized("250000000denom1,250000000denom2,250000000denom3,250000000stake"),
+		suite.keeper.GetTotalCollectedCoins(suite.ctx, budget1.ID)))
+
+	// the events are emitted in sorted order of sources, then in order of priority
+	sources := []string{budget1.SourceAddress, suite.budgets[2].SourceAddress}
+	sort.Strings(sources)
+	expectedNames := []string{budget2.Name, budget1.Name, suite.budgets[2].Name}
+	if sources[0] != budget1.SourceAddress {
+		expectedNames = []string{suite.budgets[2].Name, budget2.Name, budget1.Name}
+	}
+	var names []string
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeBudgetCollected {
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == types.AttributeValueName {
+					names = append(names, string(attr.Value))
+				}
+			}
+		}
+	}
+	suite.Require().Equal(expectedNames, names)
+}
+
+func (suite *KeeperTestSuite) TestCollectBudgetsRemainder() {
+	budget := suite.budgets[0]
+	budget.Rate = sdk.MustNewDecFromStr("0.1")
+	budget.SourceAddress = suite.sourceAddrs[4].String()
+
+	err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, suite.sourceAddrs[4], mustParseCoinsNormalized("3denom1"))
+	suite.Require().NoError(err)
+
+	budget = suite.setBudgets(budget)[0]
+
+	for i := 0; i < 3; i++ {
+		err := suite.keeper.CollectBudgets(suite.ctx)
+		suite.Require().NoError(err)
+		suite.Require().True(suite.keeper.GetTotalCollectedCoins(suite.ctx, budget.ID).IsZero())
+	}
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom1", sdk.MustNewDecFromStr("0.9"))),
+		suite.keeper.GetRemainder(suite.ctx, budget.ID))
+
+	err = suite.keeper.CollectBudgets(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(mustParseCoinsNormalized("1denom1"), suite.keeper.GetTotalCollectedCoins(suite.ctx, budget.ID)))
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom1", sdk.MustNewDecFromStr("0.2"))),
+		suite.keeper.GetRemainder(suite.ctx, budget.ID))
+
+	genState := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().Len(genState.BudgetRecords, 1)
+	suite.Require().Equal(suite.keeper.GetRemainder(suite.ctx, budget.ID), genState.BudgetRecords[0].Remainder)
+}
+
+func (suite *KeeperTestSuite) TestCollectBudgetsHistory() {
+	budget := suite.budgets[0]
+	budget.Rate = sdk.MustNewDecFromStr("0.1")
+	budget.SourceAddress = suite.sourceAddrs[4].String()
+
+	err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, suite.sourceAddrs[4], mustParseCoinsNormalized("1000denom1"))
+	suite.Require().NoError(err)
+
+	// a budget that collects nothing is not recorded
+	empty := suite.budgets[1]
+	empty.Rate = sdk.MustNewDecFromStr("0.1")
+	empty.SourceAddress = budget.SourceAddress
+	empty.AllowedDenoms = []string{denom2}
+
+	budgets := suite.setBudgets(budget, empty)
+	budget = budgets[0]
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.HistoryRetentionBlocks = 2
+	suite.keeper.SetParams(suite.ctx, params)
+
+	blockTime := types.MustParseRFC3339("2021-08-31T00:00:00Z")
+	for height := int64(1); height <= 3; height++ {
+		ctx := suite.ctx.WithBlockHeight(height).WithBlockTime(blockTime.Add(time.Duration(height) * time.Second))
+		suite.keeper.PruneCollectionRecords(ctx)
+		err := suite.keeper.CollectBudgets(ctx)
+		suite.Require().NoError(err)
+	}
+
+	// the record at height 1 is pruned at height 3
+	_, found := suite.keeper.GetCollectionRecord(suite.ctx, budget.ID, 1)
+	suite.Require().False(found)
+	record, found := suite.keeper.GetCollectionRecord(suite.ctx, budget.ID, 2)
+	suite.Require().True(found)
+	suite.Require().Equal(blockTime.Add(2*time.Second), record.Time)
+	suite.Require().True(coinsEq(mustParseCoinsNormalized("900denom1"), record.SourceBalances))
+	suite.Require().True(coinsEq(mustParseCoinsNormalized("90denom1"), record.CollectedCoins))
+	record, found = suite.keeper.GetCollectionRecord(suite.ctx, budget.ID, 3)
+	suite.Require().True(found)
+	suite.Require().True(coinsEq(mustParseCoinsNormalized("810denom1"), record.SourceBalances))
+	suite.Require().True(coinsEq(mustParseCoinsNormalized("81denom1"), record.CollectedCoins))
+	_, found = suite.keeper.GetCollectionRecord(suite.ctx, budgets[1].ID, 3)
+	suite.Require().False(found)
+	suite.Require().Len(suite.keeper.GetAllCollectionRecords(suite.ctx), 2)
+
+	// no records are kept if the history retention blocks is zero
+	params.HistoryRetentionBlocks = 0
+	suite.keeper.SetParams(suite.ctx, params)
+	ctx := suite.ctx.WithBlockHeight(4).WithBlockTime(blockTime.Add(4 * time.Second))
+	suite.keeper.PruneCollectionRecords(ctx)
+	err = suite.keeper.CollectBudgets(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.keeper.GetAllCollectionRecords(suite.ctx))
+	suite.Require().True(coinsEq(mustParseCoinsNormalized("343denom1"), suite.keeper.GetTotalCollectedCoins(suite.ctx, budget.ID)))
+}
+
+func (suite *KeeperTestSuite) TestCollectBudgetsReserve() {
+	budget := suite.budgets[0]
+	budget.Reserve = mustParseCoinsNormalized("100000000denom2")
+
+	budget = suite.setBudgets(budget)[0]
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.SourceReserves = []types.SourceReserve{
+		{SourceAddress: budget.SourceAddress, Reserve: mustParseCoinsNormalized("600000000denom1,50000000denom2")},
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	err := suite.keeper.CollectBudgets(suite.ctx)
+	suite.Require().NoError(err)
+
+	// the larger of the budget reserve and the source reserve is left untouched in the source
+	suite.Require().True(coinsEq(
+		mustParseCoinsNormalized("200000000denom1,450000000denom2,500000000denom3,500000000stake"),
+		suite.keeper.GetTotalCollectedCoins(suite.ctx, budget.ID)))
+
+	var reserves []string
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeBudgetCollected {
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == types.AttributeValueReserve {
+					reserves = append(reserves, string(attr.Value))
+				}
+			}
+		}
+	}
+	suite.Require().Equal([]string{"600000000denom1,100000000denom2"}, reserves)
+}
+
+func (suite *KeeperTestSuite) TestCollectBudgetsPaused() {
+	budget := suite.budgets[0]
+	budget.Paused = true
+
+	budget = suite.setBudgets(budget)[0]
+
+	err := suite.keeper.CollectBudgets(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.keeper.GetTotalCollectedCoins(suite.ctx, budget.ID).Empty())
+
+	resp, err := suite.querier.Budgets(sdk.WrapSDKContext(suite.ctx), &types.QueryBudgetsRequest{Name: budget.Name})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Budgets, 1)
+	suite.Require().True(resp.Budgets[0].Budget.Paused)
+
+	// resuming the budget keeps its record and collects again
+	budget.Paused = false
+	suite.keeper.SetBudget(suite.ctx, budget)
+
+	err = suite.keeper.CollectBudgets(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(
+		mustParseCoinsNormalized("500000000denom1,500000000denom2,500000000denom3,500000000stake"),
+		suite.keeper.GetTotalCollectedCoins(suite.ctx, budget.ID)))
+}
+
+func (suite *KeeperTestSuite) TestCollectBudgetsConditions() {
+	bondedRatio := suite.app.StakingKeeper.BondedRatio(suite.ctx)
+
+	for _, tc := range []struct {
+		name       string
+		conditions types.BudgetConditions
+		condition  string
+	}{
+		{
+			"source balance above the minimum",
+			types.BudgetConditions{MinSourceBalance: mustParseCoinsNormalized("1000000000denom1")},
+			"",
+		},
+		{
+			"source balance below the minimum",
+			types.BudgetConditions{MinSourceBalance: mustParseCoinsNormalized("1000000001denom1")},
+			types.ConditionMinSourceBalance,
+		},
+		{
+			"destination balance below the maximum",
+			types.BudgetConditions{MaxDestinationBalance: mustParseCoinsNormalized("1denom1")},
+			"",
+		},
+		{
+			"bonded ratio within the range",
+			types.BudgetConditions{MaxBondedRatio: decPtr(sdk.OneDec())},
+			"",
+		},
+		{
+			"bonded ratio below the minimum",
+			types.BudgetConditions{MinBondedRatio: decPtr(bondedRatio.Add(sdk.NewDecWithPrec(1, 18)))},
+			types.ConditionMinBondedRatio,
+		},
+		{
+			"nothing bonded within a zero maximum",
+			types.BudgetConditions{MaxBondedRatio: decPtr(sdk.ZeroDec())},
+			"",
+		},
+	} {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			budget := suite.budgets[0]
+			conditions := tc.conditions
+			budget.Conditions = &conditions
+			suite.Require().NoError(budget.Validate())
+
+			budget = suite.setBudgets(budget)[0]
+
+			suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+			err := suite.keeper.CollectBudgets(suite.ctx)
+			suite.Require().NoError(err)
+
+			var conditionsFailed []string
+			for _, event := range suite.ctx.EventManager().Events() {
+				if event.Type == types.EventTypeBudgetSkipped {
+					for _, attr := range event.Attributes {
+						if string(attr.Key) == types.AttributeValueCondition {
+							conditionsFailed = append(conditionsFailed, string(attr.Value))
+						}
+					}
+				}
+			}
+			if tc.condition == "" {
+				suite.Require().Empty(conditionsFailed)
+				suite.Require().False(suite.keeper.GetTotalCollectedCoins(suite.ctx, budget.ID).Empty())
+			} else {
+				suite.Require().Equal([]string{tc.condition}, conditionsFailed)
+				suite.Require().True(suite.keeper.GetTotalCollectedCoins(suite.ctx, budget.ID).Empty())
+			}
+		})
+	}
+
+	// a zero maximum bonded ratio does not hold once any tokens are bonded
+	suite.SetupTest()
+	suite.Require().True(suite.app.StakingKeeper.BondedRatio(suite.ctx).IsZero())
+	stakingHelper := teststaking.NewHelper(suite.T(), suite.ctx, suite.app.StakingKeeper)
+	valAddr := app.ConvertAddrsToValAddrs(suite.addrs[:1])[0]
+	stakingHelper.CreateValidator(valAddr, app.CreateTestPubKeys(1)[0], sdk.NewInt(1_000_000), true)
+	staking.EndBlocker(suite.ctx, suite.app.StakingKeeper)
+	suite.Require().True(suite.app.StakingKeeper.BondedRatio(suite.ctx).IsPositive())
+	zeroMax := suite.budgets[0]
+	zeroMax.Conditions = &types.BudgetConditions{MaxBondedRatio: decPtr(sdk.ZeroDec())}
+	zeroMax = suite.setBudgets(zeroMax)[0]
+	err := suite.keeper.CollectBudgets(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.keeper.GetTotalCollectedCoins(suite.ctx, zeroMax.ID).Empty())
+
+	// the destination exceeds its maximum balance after the first collection
+	suite.SetupTest()
+	budget := suite.budgets[0]
+	budget.Conditions = &types.BudgetConditions{MaxDestinationBalance: mustParseCoinsNormalized("1denom1")}
+	budget = suite.setBudgets(budget)[0]
+
+	for i := 0; i < 2; i++ {
+		err := suite.keeper.CollectBudgets(suite.ctx)
+		suite.Require().NoError(err)
+	}
+	suite.Require().True(coinsEq(
+		mustParseCoinsNormalized("500000000denom1,500000000denom2,500000000denom3,500000000stake"),
+		suite.keeper.GetTotalCollectedCoins(suite.ctx, budget.ID)))
+}
+
+func (suite *KeeperTestSuite) TestCollectBudgetsAddressReferences() {
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	budget := suite.budgets[0]
+	budget.SourceAddress = "derived:ADDRESS_TYPE_32_BYTES:budget:sourceAddr1"
+	budget.DestinationAddress = "module:" + authtypes.FeeCollectorName
+	unknownBudget := suite.budgets[2]
+	unknownBudget.DestinationAddress = "module:unknown"
+
+	budgets := suite.setBudgets(budget, unknownBudget)
+	budget, unknownBudget = budgets[0], budgets[1]
+
+	balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, feeCollector)
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	err := suite.keeper.CollectBudgets(suite.ctx)
+	suite.Require().NoError(err)
+
+	collected := mustParseCoinsNormalized("500000000denom1,500000000denom2,500000000denom3,500000000stake")
+	suite.Require().True(coinsEq(collected, suite.keeper.GetTotalCollectedCoins(suite.ctx, budget.ID)))
+	suite.Require().True(coinsEq(balancesBefore.Add(collected...), suite.app.BankKeeper.GetAllBalances(suite.ctx, feeCollector)))
+
+	// a budget whose module account does not exist is skipped
+	suite.Require().True(suite.keeper.GetTotalCollectedCoins(suite.ctx, unknownBudget.ID).Empty())
+	var skipped []string
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeBudgetSkipped {
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == types.AttributeValueCondition {
+					skipped = append(skipped, string(attr.Value))
+				}
+			}
+		}
+	}
+	suite.Require().Equal([]string{types.ConditionResolvableAddresses}, skipped)
+
+	resp, err := suite.querier.Budgets(sdk.WrapSDKContext(suite.ctx), &types.QueryBudgetsRequest{
+		SourceAddress:      suite.sourceAddrs[0].String(),
+		DestinationAddress: feeCollector.String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Budgets, 1)
+	suite.Require().Equal(budget.Name, resp.Budgets[0].Budget.Name)
+}
+
+func (suite *KeeperTestSuite) TestCollectBudgetsCommunityPool() {
+	budget := suite.budgets[0]
+	budget.DestinationAddress = ""
+	budget.Destinations = []types.BudgetDestination{
+		{Address: suite.destinationAddrs[0].String(), Weight: sdk.NewDec(3)},
+		{Weight: sdk.NewDec(1), Type: types.DestinationTypeCommunityPool},
+	}
+
+	budget = suite.setBudgets(budget)[0]
+
+	communityPoolBefore := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	err := suite.keeper.CollectBudgets(suite.ctx)
+	suite.Require().NoError(err)
+
+	suite.Require().True(coinsEq(
+		mustParseCoinsNormalized("500000000denom1,500000000denom2,500000000denom3,500000000stake"),
+		suite.keeper.GetTotalCollectedCoins(suite.ctx, budget.ID)))
+	suite.Require().True(coinsEq(
+		mustParseCoinsNormalized("375000000denom1,375000000denom2,375000000denom3,375000000stake"),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.destinationAddrs[0])))
+	funded := sdk.NewDecCoinsFromCoins(mustParseCoinsNormalized("125000000denom1,125000000denom2,125000000denom3,125000000stake")...)
+	suite.Require().Equal(communityPoolBefore.Add(funded...), suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx))
+
+	distrAcc := suite.app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	suite.Require().True(coinsEq(
+		mustParseCoinsNormalized("125000000denom1,125000000denom2,125000000denom3,125000000stake"),
+		suite.keeper.GetDestinationCollectedCoins(suite.ctx, budget.ID, distrAcc)))
+
+	var destinationTypes []string
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeBudgetCollected {
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == types.AttributeValueDestinationType {
+					destinationTypes = append(destinationTypes, string(attr.Value))
+				}
+			}
+		}
+	}
+	suite.Require().Equal([]string{types.DestinationTypeAccount.String(), types.DestinationTypeCommunityPool.String()}, destinationTypes)
+}
+
+func (suite *KeeperTestSuite) TestCollectBudgetsBurn() {
+	budget := suite.budgets[0]
+	budget.Rate = sdk.NewDecWithPrec(1, 1)
+	budget.DestinationAddress = ""
+	budget.Destinations = []types.BudgetDestination{{Weight: sdk.OneDec(), Type: types.DestinationTypeBurn}}
+
+	budget = suite.setBudgets(budget)[0]
+
+	supplyBefore := suite.app.BankKeeper.GetSupply(suite.ctx, "denom1")
+	for i := 0; i < 2; i++ {
+		err := suite.keeper.CollectBudgets(suite.ctx)
+		suite.Require().NoError(err)
+	}
+
+	burned := mustParseCoinsNormalized("190000000denom1,190000000denom2,190000000denom3,190000000stake")
+	suite.Require().True(coinsEq(burned, suite.keeper.GetTotalCollectedCoins(suite.ctx, budget.ID)))
+	suite.Require().True(coinsEq(burned, suite.keeper.GetTotalBurnedCoins(suite.ctx)))
+	suite.Require().Equal(supplyBefore.Amount.Sub(burned.AmountOf("denom1")), suite.app.BankKeeper.GetSupply(suite.ctx, "denom1").Amount)
+	moduleAcc := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, moduleAcc).Empty())
+
+	resp, err := suite.querier.TotalBurnedCoins(sdk.WrapSDKContext(suite.ctx), &types.QueryTotalBurnedCoinsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(burned, resp.TotalBurnedCoins))
+
+	genState := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().True(coinsEq(burned, genState.TotalBurnedCoins))
+	suite.SetupTest()
+	suite.keeper.InitGenesis(suite.ctx, *genState)
+	suite.Require().True(coinsEq(burned, suite.keeper.GetTotalBurnedCoins(suite.ctx)))
+}
+
+func (suite *KeeperTestSuite) TestCollectBudgetsStaking() {
+	stakingHelper := teststaking.NewHelper(suite.T(), suite.ctx, suite.app.StakingKeeper)
+	valAddrs := app.ConvertAddrsToValAddrs(suite.addrs[:3])
+	for i, pk := range app.CreateTestPubKeys(3) {
+		stakingHelper.CreateValidator(valAddrs[i], pk, sdk.NewInt(1_000_000), true)
+	}
+	validator, found := suite.app.StakingKeeper.GetValidator(suite.ctx, valAddrs[2])
+	suite.Require().True(found)
+	consAddr, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+	suite.app.StakingKeeper.Jail(suite.ctx, consAddr)
+
+	budget := suite.budgets[0]
+	budget.Rate = sdk.NewDecWithPrec(1, 1)
+	budget.DestinationAddress = ""
+	budget.AllowedDenoms = []string{sdk.DefaultBondDenom}
+	budget.Destinations = []types.BudgetDestination{{
+		Weight:     sdk.OneDec(),
+		Type:       types.DestinationTypeStaking,
+		Validators: []string{valAddrs[0].String(), valAddrs[1].String(), valAddrs[2].String()},
+	}}
+	suite.Require().NoError(budget.Validate())
+
+	budget = suite.setBudgets(budget)[0]
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	err = suite.keeper.CollectBudgets(suite.ctx)
+	suite.Require().NoError(err)
+
+	// The jailed validator is skipped.
+	delegatorAcc := types.DelegatorAddress(budget.ID)
+	for i, expected := range []int64{50_000_000, 50_000_000, 0} {
+		delegation, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, delegatorAcc, valAddrs[i])
+		if expected == 0 {
+			suite.Require().False(found)
+			continue
+		}
+		suite.Require().True(found)
+		suite.Require().Equal(sdk.NewDec(expected), delegation.Shares)
+	}
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, delegatorAcc).Empty())
+	suite.Require().True(coinsEq(
+		mustParseCoinsNormalized("100000000stake"),
+		suite.keeper.GetDestinationCollectedCoins(suite.ctx, budget.ID, delegatorAcc)))
+
+	delegated := 0
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeBudgetDelegated {
+			delegated++
+		}
+	}
+	suite.Require().Equal(2, delegated)
+}
+
+func (suite *KeeperTestSuite) TestCollectBudgetsStakingRewards() {
+	stakingHelper := teststaking.NewHelper(suite.T(), suite.ctx, suite.app.StakingKeeper)
+	valAddr := app.ConvertAddrsToValAddrs(suite.addrs[:1])[0]
+	stakingHelper.CreateValidator(valAddr, app.CreateTestPubKeys(1)[0], sdk.NewInt(1_000_000), true)
+
+	budget := suite.budgets[0]
+	budget.Rate = sdk.NewDecWithPrec(1, 1)
+	budget.DestinationAddress = ""
+	budget.AllowedDenoms = []string{sdk.DefaultBondDenom}
+	budget.Destinations = []types.BudgetDestination{{
+		Weight:     sdk.OneDec(),
+		Type:       types.DestinationTypeStaking,
+		Validators: []string{valAddr.String()},
+	}}
+
+	budget = suite.setBudgets(budget)[0]
+
+	// Delegations do not earn rewards in the block in which they are created.
+	allocateRewards := func(rewards sdk.Coins) {
+		suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+		err := app.FundModuleAccount(suite.app.BankKeeper, suite.ctx, distrtypes.ModuleName, rewards)
+		suite.Require().NoError(err)
+		validator, _ := suite.app.StakingKeeper.GetValidator(suite.ctx, valAddr)
+		suite.app.DistrKeeper.AllocateTokensToValidator(suite.ctx, validator, sdk.NewDecCoinsFromCoins(rewards...))
+	}
+
+	err := suite.keeper.CollectBudgets(suite.ctx)
+	suite.Require().NoError(err)
+
+	// The rewards are delegated again without a rewards address, and the rewards that are not the bond denom
+	// are returned to the source. The validator has 101000000 tokens, so that the delegation of 100000000 tokens
+	// earns 100000000 of the rewards.
+	delegatorAcc := types.DelegatorAddress(budget.ID)
+	sourceDenom1 := suite.app.BankKeeper.GetBalance(suite.ctx, suite.sourceAddrs[0], denom1).Amount
+	allocateRewards(mustParseCoinsNormalized("101000000denom1,101000000stake"))
+	err = suite.keeper.CollectBudgets(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, delegatorAcc).Empty())
+	suite.Require().Equal(sourceDenom1.AddRaw(100_000_000), suite.app.BankKeeper.GetBalance(suite.ctx, suite.sourceAddrs[0], denom1).Amount)
+	delegation, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, delegatorAcc, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(100_000_000+100_000_000+90_000_000), delegation.Shares)
+
+	// The rewards are forwarded to the rewards address if it is set, and the delegator account of the
+	// budget is kept when it is renamed.
+	budget.Name = "renamed"
+	budget.Destinations[0].RewardsAddress = suite.destinationAddrs[0].String()
+	suite.keeper.SetBudget(suite.ctx, budget)
+	suite.Require().Equal(delegatorAcc, types.DelegatorAddress(budget.ID))
+	allocateRewards(mustParseCoinsNormalized("291000000stake"))
+	err = suite.keeper.CollectBudgets(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(coinsEq(
+		mustParseCoinsNormalized("290000000stake"),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.destinationAddrs[0])))
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, delegatorAcc).Empty())
+	delegation, found = suite.app.StakingKeeper.GetDelegation(suite.ctx, delegatorAcc, valAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(290_000_000+81_000_000), delegation.Shares)
+}
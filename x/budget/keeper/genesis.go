@@ -14,14 +14,64 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		panic(err)
 	}
 
+	for _, budget := range genState.Budgets {
+		if _, err := k.ResolveBudget(budget); err != nil {
+			panic(err)
+		}
+		if err := k.ValidateStakingBudget(ctx, budget); err != nil {
+			panic(err)
+		}
+	}
+
 	k.SetParams(ctx, genState.Params)
 	moduleAcc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 	if moduleAcc == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
 
+	if !genState.TotalBurnedCoins.Empty() {
+		k.SetTotalBurnedCoins(ctx, genState.TotalBurnedCoins)
+	}
+
+	if !genState.LastEpochTime.IsZero() {
+		k.SetLastEpochTime(ctx, genState.LastEpochTime)
+	}
+
+	k.SetLastBudgetID(ctx, genState.LastBudgetID)
+	for _, budget := range genState.Budgets {
+		k.SetBudget(ctx, budget)
+	}
+
 	for _, record := range genState.BudgetRecords {
-		k.SetTotalCollectedCoins(ctx, record.Name, record.TotalCollectedCoins)
+		k.SetTotalCollectedCoins(ctx, record.BudgetID, record.TotalCollectedCoins)
+		if record.NextPeriod > 0 {
+			k.SetNextPeriod(ctx, record.BudgetID, record.NextPeriod)
+		}
+		k.SetRemainder(ctx, record.BudgetID, record.Remainder)
+		if record.LastCollectedHeight > 0 {
+			k.SetLastCollectedHeight(ctx, record.BudgetID, record.LastCollectedHeight)
+		}
+		if record.Status != types.BudgetStatusUnspecified {
+			k.SetBudgetStatus(ctx, record.BudgetID, record.Status)
+		}
+		if record.Unbonding {
+			k.SetUnbondingBudget(ctx, record.BudgetID, true)
+		}
+		for _, destinationRecord := range record.DestinationCollectedCoins {
+			destinationAcc, err := sdk.AccAddressFromBech32(destinationRecord.DestinationAddress)
+			if err != nil {
+				panic(err)
+			}
+			k.SetDestinationCollectedCoins(ctx, record.BudgetID, destinationAcc, destinationRecord.TotalCollectedCoins)
+		}
+	}
+
+	for _, record := range genState.CollectionRecords {
+		k.SetCollectionRecord(ctx, record)
+	}
+
+	for _, archived := range genState.ArchivedBudgets {
+		k.SetArchivedBudget(ctx, archived)
 	}
 }
 
@@ -31,9 +81,64 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	var budgetRecords []types.BudgetRecord
 
 	k.IterateAllTotalCollectedCoins(ctx, func(record types.BudgetRecord) (stop bool) {
+		record.DestinationCollectedCoins = k.GetAllDestinationCollectedCoins(ctx, record.BudgetID)
+		record.NextPeriod = k.GetNextPeriod(ctx, record.BudgetID)
+		record.Remainder = k.GetRemainder(ctx, record.BudgetID)
+		record.LastCollectedHeight = k.GetLastCollectedHeight(ctx, record.BudgetID)
+		record.Status = k.GetBudgetStatus(ctx, record.BudgetID)
+		record.Unbonding = k.IsUnbondingBudget(ctx, record.BudgetID)
 		budgetRecords = append(budgetRecords, record)
 		return false
 	})
 
-	return types.NewGenesisState(params, budgetRecords)
+	// A budget may have passed its periods or epochs without collecting any coins.
+	k.IterateAllNextPeriods(ctx, func(budgetID uint64, period uint64) (stop bool) {
+		for _, record := range budgetRecords {
+			if record.BudgetID == budgetID {
+				return false
+			}
+		}
+		budgetRecords = append(budgetRecords, types.BudgetRecord{
+			BudgetID:            budgetID,
+			NextPeriod:          period,
+			LastCollectedHeight: k.GetLastCollectedHeight(ctx, budgetID),
+			Status:              k.GetBudgetStatus(ctx, budgetID),
+			Unbonding:           k.IsUnbondingBudget(ctx, budgetID),
+		})
+		return false
+	})
+	k.IterateAllLastCollectedHeights(ctx, func(budgetID uint64, height int64) (stop bool) {
+		for _, record := range budgetRecords {
+			if record.BudgetID == budgetID {
+				return false
+			}
+		}
+		budgetRecords = append(budgetRecords, types.BudgetRecord{
+			BudgetID:            budgetID,
+			LastCollectedHeight: height,
+			Status:              k.GetBudgetStatus(ctx, budgetID),
+			Unbonding:           k.IsUnbondingBudget(ctx, budgetID),
+		})
+		return false
+	})
+	k.IterateAllBudgetStatuses(ctx, func(budgetID uint64, status types.BudgetStatus) (stop bool) {
+		for _, record := range budgetRecords {
+			if record.BudgetID == budgetID {
+				return false
+			}
+		}
+		budgetRecords = append(budgetRecords, types.BudgetRecord{
+			BudgetID:  budgetID,
+			Status:    status,
+			Unbonding: k.IsUnbondingBudget(ctx, budgetID),
+		})
+		return false
+	})
+
+	genState := types.NewGenesisState(params, k.GetAllBudgets(ctx), k.GetLastBudgetID(ctx), budgetRecords)
+	genState.TotalBurnedCoins = k.GetTotalBurnedCoins(ctx)
+	genState.LastEpochTime = k.GetLastEpochTime(ctx)
+	genState.CollectionRecords = k.GetAllCollectionRecords(ctx)
+	genState.ArchivedBudgets = k.GetAllArchivedBudgets(ctx)
+	return genState
 }
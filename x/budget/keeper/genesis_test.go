@@ -8,9 +8,7 @@ import (
 
 func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.SetupTest()
-	params := suite.keeper.GetParams(suite.ctx)
-	params.Budgets = suite.budgets[:4]
-	suite.keeper.SetParams(suite.ctx, params)
+	suite.setBudgets(suite.budgets[:4]...)
 
 	emptyGenState := suite.keeper.ExportGenesis(suite.ctx)
 	suite.Require().NotPanics(func() {
@@ -34,4 +32,32 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 		suite.keeper.InitGenesis(suite.ctx, *genState)
 	})
 	suite.Require().Equal(genState, suite.keeper.ExportGenesis(suite.ctx))
+
+	// a budget that lost its staking destination stays marked as unbonding
+	suite.keeper.SetUnbondingBudget(suite.ctx, 1, true)
+	genState = suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().Equal(uint64(1), genState.BudgetRecords[0].BudgetID)
+	suite.Require().True(genState.BudgetRecords[0].Unbonding)
+	suite.keeper.SetUnbondingBudget(suite.ctx, 1, false)
+	suite.keeper.InitGenesis(suite.ctx, *genState)
+	suite.Require().True(suite.keeper.IsUnbondingBudget(suite.ctx, 1))
+}
+
+func (suite *KeeperTestSuite) TestInitGenesisAddressReferences() {
+	budget := suite.budgets[0]
+	budget.DestinationAddress = "module:fee_collector"
+	budget.ID = 1
+	genState := types.DefaultGenesisState()
+	genState.Budgets = []types.Budget{budget}
+	genState.LastBudgetID = 1
+	suite.Require().NotPanics(func() {
+		suite.keeper.InitGenesis(suite.ctx, *genState)
+	})
+
+	// the module account of a module reference must exist
+	budget.DestinationAddress = "module:unknown"
+	genState.Budgets = []types.Budget{budget}
+	suite.Require().Panics(func() {
+		suite.keeper.InitGenesis(suite.ctx, *genState)
+	})
 }
@@ -2,11 +2,15 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/tendermint/budget/x/budget/types"
 )
@@ -32,38 +36,287 @@ func (k Querier) Budgets(c context.Context, req *types.QueryBudgetsRequest) (*ty
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
+	var sourceAcc, destinationAcc sdk.AccAddress
 	if req.SourceAddress != "" {
-		if _, err := sdk.AccAddressFromBech32(req.SourceAddress); err != nil {
+		var err error
+		if sourceAcc, err = sdk.AccAddressFromBech32(req.SourceAddress); err != nil {
 			return nil, err
 		}
 	}
 
 	if req.DestinationAddress != "" {
-		if _, err := sdk.AccAddressFromBech32(req.DestinationAddress); err != nil {
+		var err error
+		if destinationAcc, err = sdk.AccAddressFromBech32(req.DestinationAddress); err != nil {
 			return nil, err
 		}
 	}
 
+	if _, ok := types.BudgetStatus_name[int32(req.Status)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid budget status %s", req.Status)
+	}
+
+	if _, ok := types.BudgetOrder_name[int32(req.OrderBy)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid budget order %s", req.OrderBy)
+	}
+
+	if req.OrderBy == types.BudgetOrderTotalCollected {
+		if err := sdk.ValidateDenom(req.OrderDenom); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid order denom: %v", err)
+		}
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
-	var params types.Params
-	k.paramSpace.GetParamSet(ctx, &params)
+	matches := func(b types.Budget) bool {
+		// Address references of the budget are compared by the addresses they resolve to.
+		resolved, err := k.ResolveBudget(b)
+		if err != nil {
+			resolved = b
+		}
+		return (req.Name == "" || b.Name == req.Name) &&
+			(req.SourceAddress == "" || resolved.SourceAddress == req.SourceAddress) &&
+			(req.DestinationAddress == "" || resolved.HasDestination(req.DestinationAddress)) &&
+			(!req.Collectible || b.Collectible(ctx.BlockTime(), ctx.BlockHeight())) &&
+			(req.Tag == "" || b.HasTag(req.Tag))
+	}
+	matchesLive := func(b types.Budget) (types.BudgetResponse, bool) {
+		budgetStatus := b.Status(ctx.BlockTime(), ctx.BlockHeight())
+		if req.Status != types.BudgetStatusUnspecified && budgetStatus != req.Status || !matches(b) {
+			return types.BudgetResponse{}, false
+		}
+		collectedCoins := k.GetTotalCollectedCoins(ctx, b.ID)
+		return types.BudgetResponse{
+			Budget:                    b,
+			TotalCollectedCoins:       collectedCoins,
+			Exhausted:                 b.Exhausted(collectedCoins),
+			DestinationCollectedCoins: k.GetAllDestinationCollectedCoins(ctx, b.ID),
+			Status:                    budgetStatus,
+		}, true
+	}
 
-	var budgets []types.BudgetResponse
-	for _, b := range params.Budgets {
-		if req.Name != "" && b.Name != req.Name ||
-			req.SourceAddress != "" && b.SourceAddress != req.SourceAddress ||
-			req.DestinationAddress != "" && b.DestinationAddress != req.DestinationAddress {
-			continue
+	// The archived budgets are returned only if they are filtered by their status.
+	includeArchived := req.Status == types.BudgetStatusExpired || req.Status == types.BudgetStatusRemoved
+
+	// The budgets in order of id are paginated over the store, using the index of the address if filtered by one.
+	if req.OrderBy == types.BudgetOrderID && req.Name == "" && !includeArchived {
+		var store prefix.Store
+		switch {
+		case sourceAcc != nil:
+			store = prefix.NewStore(ctx.KVStore(k.storeKey), types.GetBudgetsBySourceIndexKey(sourceAcc))
+		case destinationAcc != nil:
+			store = prefix.NewStore(ctx.KVStore(k.storeKey), types.GetBudgetsByDestinationIndexKey(destinationAcc))
+		default:
+			store = prefix.NewStore(ctx.KVStore(k.storeKey), types.BudgetKeyPrefix)
 		}
 
-		collectedCoins := k.GetTotalCollectedCoins(ctx, b.Name)
-		budgets = append(budgets, types.BudgetResponse{
-			Budget:              b,
-			TotalCollectedCoins: collectedCoins,
+		var budgets []types.BudgetResponse
+		pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, _ []byte, accumulate bool) (bool, error) {
+			b, found := k.GetBudget(ctx, sdk.BigEndianToUint64(key))
+			if !found {
+				return false, nil
+			}
+			budget, ok := matchesLive(b)
+			if !ok {
+				return false, nil
+			}
+			if accumulate {
+				budgets = append(budgets, budget)
+			}
+			return true, nil
 		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		return &types.QueryBudgetsResponse{Budgets: budgets, Pagination: pageRes}, nil
+	}
+
+	// Otherwise, the candidates selected by the name or address index are filtered, sorted and paginated.
+	var candidates []types.Budget
+	switch {
+	case req.Name != "":
+		if b, found := k.GetBudgetByName(ctx, req.Name); found {
+			candidates = append(candidates, b)
+		}
+	case sourceAcc != nil || destinationAcc != nil:
+		var ids []uint64
+		if sourceAcc != nil {
+			ids = k.GetBudgetIDsBySource(ctx, sourceAcc)
+		} else {
+			ids = k.GetBudgetIDsByDestination(ctx, destinationAcc)
+		}
+		for _, id := range ids {
+			if b, found := k.GetBudget(ctx, id); found {
+				candidates = append(candidates, b)
+			}
+		}
+	default:
+		candidates = k.GetAllBudgets(ctx)
+	}
+
+	var budgets []types.BudgetResponse
+	for _, b := range candidates {
+		if budget, ok := matchesLive(b); ok {
+			budgets = append(budgets, budget)
+		}
+	}
+
+	if includeArchived {
+		for _, archived := range k.GetAllArchivedBudgets(ctx) {
+			if archived.Status != req.Status || !matches(archived.Budget) {
+				continue
+			}
+			budgets = append(budgets, types.BudgetResponse{
+				Budget:                    archived.Budget,
+				TotalCollectedCoins:       archived.TotalCollectedCoins,
+				Exhausted:                 archived.Budget.Exhausted(archived.TotalCollectedCoins),
+				DestinationCollectedCoins: archived.DestinationCollectedCoins,
+				Status:                    archived.Status,
+			})
+		}
+	}
+
+	sortBudgets(budgets, req.OrderBy, req.OrderDenom)
+	budgets, pageRes, err := paginateBudgets(budgets, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryBudgetsResponse{Budgets: budgets, Pagination: pageRes}, nil
+}
+
+// sortBudgets sorts the budgets by the order, breaking ties by their ids.
+func sortBudgets(budgets []types.BudgetResponse, order types.BudgetOrder, denom string) {
+	sort.SliceStable(budgets, func(i, j int) bool {
+		a, b := budgets[i], budgets[j]
+		switch order {
+		case types.BudgetOrderName:
+			if a.Budget.Name != b.Budget.Name {
+				return a.Budget.Name < b.Budget.Name
+			}
+		case types.BudgetOrderStartTime:
+			if !a.Budget.StartTime.Equal(b.Budget.StartTime) {
+				return a.Budget.StartTime.Before(b.Budget.StartTime)
+			}
+		case types.BudgetOrderTotalCollected:
+			amtA, amtB := a.TotalCollectedCoins.AmountOf(denom), b.TotalCollectedCoins.AmountOf(denom)
+			if !amtA.Equal(amtB) {
+				return amtA.LT(amtB)
+			}
+		}
+		return a.Budget.ID < b.Budget.ID
+	})
+}
+
+// paginateBudgets returns the page of the sorted budgets by the page request. The budgets are paginated by
+// the offset or the key of the page request, and the next key of the page response is the offset of the next
+// page in big endian.
+func paginateBudgets(budgets []types.BudgetResponse, pageReq *query.PageRequest) ([]types.BudgetResponse, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	offset := pageReq.Offset
+	if pageReq.Key != nil {
+		if len(pageReq.Key) != 8 {
+			return nil, nil, fmt.Errorf("invalid pagination key")
+		}
+		offset = sdk.BigEndianToUint64(pageReq.Key)
+	}
+
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+
+	if pageReq.Reverse {
+		for i, j := 0, len(budgets)-1; i < j; i, j = i+1, j-1 {
+			budgets[i], budgets[j] = budgets[j], budgets[i]
+		}
+	}
+
+	total := uint64(len(budgets))
+	pageRes := &query.PageResponse{}
+	if countTotal && pageReq.Key == nil {
+		pageRes.Total = total
+	}
+	if offset >= total {
+		return nil, pageRes, nil
+	}
+	end := offset + limit
+	if end < total {
+		pageRes.NextKey = sdk.Uint64ToBigEndian(end)
+	} else {
+		end = total
+	}
+	return budgets[offset:end], pageRes, nil
+}
+
+// CollectionHistory queries the collection records of a budget.
+func (k Querier) CollectionHistory(c context.Context, req *types.QueryCollectionHistoryRequest) (*types.QueryCollectionHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.BudgetId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "budget id cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCollectionRecordsByBudgetKey(req.BudgetId))
+
+	var records []types.CollectionRecord
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var record types.CollectionRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCollectionHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// Projections queries the next collection of each active budget.
+func (k Querier) Projections(c context.Context, req *types.QueryProjectionsRequest) (*types.QueryProjectionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.BlockInterval < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "block interval must not be negative: %s", req.BlockInterval)
+	}
+
+	blockInterval := req.BlockInterval
+	if blockInterval == 0 {
+		blockInterval = DefaultProjectionBlockInterval
 	}
 
-	return &types.QueryBudgetsResponse{Budgets: budgets}, nil
+	ctx := sdk.UnwrapSDKContext(c)
+	projections, err := k.ProjectCollections(ctx, blockInterval)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProjectionsResponse{Projections: projections}, nil
+}
+
+// TotalBurnedCoins queries the total coins burned by all budgets.
+func (k Querier) TotalBurnedCoins(c context.Context, req *types.QueryTotalBurnedCoinsRequest) (*types.QueryTotalBurnedCoinsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryTotalBurnedCoinsResponse{TotalBurnedCoins: k.GetTotalBurnedCoins(ctx)}, nil
 }
 
 // Addresses queries an address that can be used as source and destination is derived according to the given name, module name and address type.
@@ -1,10 +1,14 @@
 package keeper_test
 
 import (
+	"time"
+
 	_ "github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/tendermint/budget/x/budget/keeper"
 	"github.com/tendermint/budget/x/budget/types"
 )
 
@@ -21,23 +25,25 @@ func (suite *KeeperTestSuite) TestGRPCBudgets() {
 			Rate:               sdk.NewDecWithPrec(5, 2),
 			SourceAddress:      suite.sourceAddrs[0].String(),
 			DestinationAddress: suite.destinationAddrs[0].String(),
-			StartTime:          types.MustParseRFC3339("0000-01-01T00:00:00Z"),
+			StartTime:          types.MustParseRFC3339("0001-01-01T00:00:00Z"),
 			EndTime:            types.MustParseRFC3339("9999-12-31T00:00:00Z"),
+			Tags:               []string{"liquidity-farming", "incentives"},
 		},
 		{
 			Name:               "budget2",
 			Rate:               sdk.NewDecWithPrec(5, 2),
 			SourceAddress:      suite.sourceAddrs[0].String(),
 			DestinationAddress: suite.destinationAddrs[1].String(),
-			StartTime:          types.MustParseRFC3339("0000-01-01T00:00:00Z"),
+			StartTime:          types.MustParseRFC3339("0001-01-01T00:00:00Z"),
 			EndTime:            types.MustParseRFC3339("9999-12-31T00:00:00Z"),
+			Tags:               []string{"incentives"},
 		},
 		{
 			Name:               "budget3",
 			Rate:               sdk.NewDecWithPrec(5, 2),
 			SourceAddress:      suite.sourceAddrs[1].String(),
 			DestinationAddress: suite.destinationAddrs[0].String(),
-			StartTime:          types.MustParseRFC3339("0000-01-01T00:00:00Z"),
+			StartTime:          types.MustParseRFC3339("0001-01-01T00:00:00Z"),
 			EndTime:            types.MustParseRFC3339("9999-12-31T00:00:00Z"),
 		},
 		{
@@ -45,14 +51,12 @@ func (suite *KeeperTestSuite) TestGRPCBudgets() {
 			Rate:               sdk.NewDecWithPrec(5, 2),
 			SourceAddress:      suite.sourceAddrs[1].String(),
 			DestinationAddress: suite.destinationAddrs[1].String(),
-			StartTime:          types.MustParseRFC3339("0000-01-01T00:00:00Z"),
+			StartTime:          types.MustParseRFC3339("0001-01-01T00:00:00Z"),
 			EndTime:            types.MustParseRFC3339("9999-12-31T00:00:00Z"),
 		},
 	}
 
-	params := suite.keeper.GetParams(suite.ctx)
-	params.Budgets = budgets
-	suite.keeper.SetParams(suite.ctx, params)
+	suite.setBudgets(budgets...)
 
 	balance := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.sourceAddrs[0])
 	expectedCoins, _ := sdk.NewDecCoinsFromCoins(balance...).MulDec(sdk.NewDecWithPrec(5, 2)).TruncateDecimal()
@@ -96,6 +100,7 @@ func (suite *KeeperTestSuite) TestGRPCBudgets() {
 			func(resp *types.QueryBudgetsResponse) {
 				suite.Require().Len(resp.Budgets, 1)
 				suite.Require().Equal("budget1", resp.Budgets[0].Budget.Name)
+				suite.Require().Equal(uint64(1), resp.Budgets[0].Budget.ID)
 			},
 		},
 		{
@@ -145,6 +150,26 @@ func (suite *KeeperTestSuite) TestGRPCBudgets() {
 				suite.Require().Equal(suite.destinationAddrs[1].String(), resp.Budgets[0].Budget.DestinationAddress)
 			},
 		},
+		{
+			"query by tag",
+			&types.QueryBudgetsRequest{Tag: "incentives"},
+			false,
+			func(resp *types.QueryBudgetsResponse) {
+				suite.Require().Len(resp.Budgets, 2)
+				for _, b := range resp.Budgets {
+					suite.Require().Contains(b.Budget.Tags, "incentives")
+				}
+			},
+		},
+		{
+			"query by tag with other filters",
+			&types.QueryBudgetsRequest{Tag: "incentives", DestinationAddress: suite.destinationAddrs[0].String()},
+			false,
+			func(resp *types.QueryBudgetsResponse) {
+				suite.Require().Len(resp.Budgets, 1)
+				suite.Require().Equal("budget1", resp.Budgets[0].Budget.Name)
+			},
+		},
 		{
 			"correct total collected coins",
 			&types.QueryBudgetsRequest{Name: "budget1"},
@@ -167,6 +192,339 @@ func (suite *KeeperTestSuite) TestGRPCBudgets() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCBudgetsCollectible() {
+	budgets := []types.Budget{
+		{
+			Name:               "budget1",
+			Rate:               sdk.NewDecWithPrec(5, 2),
+			SourceAddress:      suite.sourceAddrs[0].String(),
+			DestinationAddress: suite.destinationAddrs[0].String(),
+			StartTime:          types.MustParseRFC3339("2021-08-01T00:00:00Z"),
+			EndTime:            types.MustParseRFC3339("2021-09-01T00:00:00Z"),
+		},
+		{
+			Name:               "budget2",
+			Rate:               sdk.NewDecWithPrec(5, 2),
+			SourceAddress:      suite.sourceAddrs[0].String(),
+			DestinationAddress: suite.destinationAddrs[1].String(),
+			StartHeight:        10,
+			EndHeight:          20,
+		},
+	}
+
+	suite.setBudgets(budgets...)
+
+	for _, tc := range []struct {
+		blockTime time.Time
+		height    int64
+		expected  []string
+	}{
+		{types.MustParseRFC3339("2021-08-31T00:00:00Z"), 5, []string{"budget1"}},
+		{types.MustParseRFC3339("2021-08-31T00:00:00Z"), 10, []string{"budget1", "budget2"}},
+		{types.MustParseRFC3339("2021-09-01T00:00:00Z"), 19, []string{"budget2"}},
+		{types.MustParseRFC3339("2021-09-01T00:00:00Z"), 20, nil},
+	} {
+		ctx := suite.ctx.WithBlockTime(tc.blockTime).WithBlockHeight(tc.height)
+		resp, err := suite.querier.Budgets(sdk.WrapSDKContext(ctx), &types.QueryBudgetsRequest{Collectible: true})
+		suite.Require().NoError(err)
+		var names []string
+		for _, b := range resp.Budgets {
+			names = append(names, b.Budget.Name)
+		}
+		suite.Require().Equal(tc.expected, names)
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCBudgetsStatus() {
+	budgets := suite.setBudgets(suite.budgets[0], suite.budgets[3], suite.budgets[6])
+	ctx := suite.ctx.WithBlockTime(types.MustParseRFC3339("2021-08-31T00:00:00Z"))
+
+	names := func(status types.BudgetStatus) []string {
+		resp, err := suite.querier.Budgets(sdk.WrapSDKContext(ctx), &types.QueryBudgetsRequest{Status: status})
+		suite.Require().NoError(err)
+		var names []string
+		for _, b := range resp.Budgets {
+			if status != types.BudgetStatusUnspecified {
+				suite.Require().Equal(status, b.Status)
+			}
+			names = append(names, b.Budget.Name)
+		}
+		return names
+	}
+
+	suite.Require().Equal([]string{budgets[0].Name, budgets[1].Name, budgets[2].Name}, names(types.BudgetStatusUnspecified))
+	suite.Require().Equal([]string{budgets[0].Name}, names(types.BudgetStatusActive))
+	suite.Require().Equal([]string{budgets[2].Name}, names(types.BudgetStatusUpcoming))
+	suite.Require().Equal([]string{budgets[1].Name}, names(types.BudgetStatusExpired))
+
+	// the archived budgets are returned only if they are filtered by their status
+	suite.keeper.UpdateBudgetStatuses(ctx)
+	suite.keeper.ArchiveBudget(ctx, budgets[2].ID, types.BudgetStatusRemoved)
+	suite.Require().Equal([]string{budgets[0].Name}, names(types.BudgetStatusUnspecified))
+	suite.Require().Equal([]string{budgets[1].Name}, names(types.BudgetStatusExpired))
+	suite.Require().Equal([]string{budgets[2].Name}, names(types.BudgetStatusRemoved))
+
+	_, err := suite.querier.Budgets(sdk.WrapSDKContext(ctx), &types.QueryBudgetsRequest{Status: 10})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestGRPCBudgetsPagination() {
+	budgets := suite.setBudgets(suite.budgets[:6]...)
+
+	ids := func(resp *types.QueryBudgetsResponse) []uint64 {
+		var ids []uint64
+		for _, b := range resp.Budgets {
+			ids = append(ids, b.Budget.ID)
+		}
+		return ids
+	}
+
+	// the budgets in order of id are paginated over the store
+	resp, err := suite.querier.Budgets(sdk.WrapSDKContext(suite.ctx), &types.QueryBudgetsRequest{
+		Pagination: &query.PageRequest{Limit: 4, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{1, 2, 3, 4}, ids(resp))
+	suite.Require().Equal(uint64(6), resp.Pagination.Total)
+	resp, err = suite.querier.Budgets(sdk.WrapSDKContext(suite.ctx), &types.QueryBudgetsRequest{
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 4},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{5, 6}, ids(resp))
+	suite.Require().Nil(resp.Pagination.NextKey)
+
+	// the budgets filtered by an address are paginated over its index
+	resp, err = suite.querier.Budgets(sdk.WrapSDKContext(suite.ctx), &types.QueryBudgetsRequest{
+		SourceAddress: suite.sourceAddrs[3].String(),
+		Pagination:    &query.PageRequest{Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{5}, ids(resp))
+	resp, err = suite.querier.Budgets(sdk.WrapSDKContext(suite.ctx), &types.QueryBudgetsRequest{
+		SourceAddress: suite.sourceAddrs[3].String(),
+		Pagination:    &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{6}, ids(resp))
+	resp, err = suite.querier.Budgets(sdk.WrapSDKContext(suite.ctx), &types.QueryBudgetsRequest{
+		DestinationAddress: suite.destinationAddrs[1].String(),
+		Pagination:         &query.PageRequest{Reverse: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{6, 2}, ids(resp))
+
+	// the budgets in other orders are sorted with ties broken by their ids, and paginated by offset or key
+	suite.keeper.SetTotalCollectedCoins(suite.ctx, budgets[0].ID, mustParseCoinsNormalized("300denom1"))
+	suite.keeper.SetTotalCollectedCoins(suite.ctx, budgets[2].ID, mustParseCoinsNormalized("100denom1,500denom2"))
+	suite.keeper.SetTotalCollectedCoins(suite.ctx, budgets[4].ID, mustParseCoinsNormalized("200denom1"))
+	resp, err = suite.querier.Budgets(sdk.WrapSDKContext(suite.ctx), &types.QueryBudgetsRequest{
+		OrderBy:    types.BudgetOrderTotalCollected,
+		OrderDenom: "denom1",
+		Pagination: &query.PageRequest{Reverse: true, Limit: 4, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{1, 5, 3, 6}, ids(resp))
+	suite.Require().Equal(uint64(6), resp.Pagination.Total)
+	resp, err = suite.querier.Budgets(sdk.WrapSDKContext(suite.ctx), &types.QueryBudgetsRequest{
+		OrderBy:    types.BudgetOrderTotalCollected,
+		OrderDenom: "denom1",
+		Pagination: &query.PageRequest{Reverse: true, Key: resp.Pagination.NextKey, Limit: 4},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{4, 2}, ids(resp))
+	suite.Require().Nil(resp.Pagination.NextKey)
+
+	budgets[0].Name = "budget9"
+	budgets[0].StartTime = types.MustParseRFC3339("2021-08-01T00:00:00Z")
+	suite.keeper.SetBudget(suite.ctx, budgets[0])
+	resp, err = suite.querier.Budgets(sdk.WrapSDKContext(suite.ctx), &types.QueryBudgetsRequest{
+		OrderBy:    types.BudgetOrderName,
+		Pagination: &query.PageRequest{Offset: 3},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{5, 6, 1}, ids(resp))
+	resp, err = suite.querier.Budgets(sdk.WrapSDKContext(suite.ctx), &types.QueryBudgetsRequest{
+		SourceAddress: suite.sourceAddrs[0].String(),
+		OrderBy:       types.BudgetOrderStartTime,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{2, 1}, ids(resp))
+	resp, err = suite.querier.Budgets(sdk.WrapSDKContext(suite.ctx), &types.QueryBudgetsRequest{
+		OrderBy: types.BudgetOrderStartTime,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{2, 3, 4, 5, 6, 1}, ids(resp))
+
+	for _, req := range []*types.QueryBudgetsRequest{
+		{OrderBy: 10},
+		{OrderBy: types.BudgetOrderTotalCollected},
+		{OrderBy: types.BudgetOrderName, Pagination: &query.PageRequest{Key: sdk.Uint64ToBigEndian(1), Offset: 1}},
+	} {
+		_, err = suite.querier.Budgets(sdk.WrapSDKContext(suite.ctx), req)
+		suite.Require().Error(err)
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCBudgetsTagPagination() {
+	var tagged []types.Budget
+	for i, budget := range suite.budgets[:6] {
+		switch i {
+		case 0, 2, 3, 5:
+			budget.Tags = []string{"grants"}
+		case 1:
+			budget.Tags = []string{"grants-other"}
+		}
+		tagged = append(tagged, budget)
+	}
+	suite.setBudgets(tagged...)
+
+	ids := func(resp *types.QueryBudgetsResponse) []uint64 {
+		var ids []uint64
+		for _, b := range resp.Budgets {
+			suite.Require().True(b.Budget.HasTag("grants"))
+			ids = append(ids, b.Budget.ID)
+		}
+		return ids
+	}
+
+	// only the budgets with the tag are paginated in order of id
+	resp, err := suite.querier.Budgets(sdk.WrapSDKContext(suite.ctx), &types.QueryBudgetsRequest{
+		Tag:        "grants",
+		Pagination: &query.PageRequest{Limit: 3, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{1, 3, 4}, ids(resp))
+	suite.Require().Equal(uint64(4), resp.Pagination.Total)
+	resp, err = suite.querier.Budgets(sdk.WrapSDKContext(suite.ctx), &types.QueryBudgetsRequest{
+		Tag:        "grants",
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 3},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{6}, ids(resp))
+	suite.Require().Nil(resp.Pagination.NextKey)
+
+	// and in other orders, with other filters
+	resp, err = suite.querier.Budgets(sdk.WrapSDKContext(suite.ctx), &types.QueryBudgetsRequest{
+		Tag:        "grants",
+		OrderBy:    types.BudgetOrderName,
+		Pagination: &query.PageRequest{Offset: 1, Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{3, 4}, ids(resp))
+	suite.Require().Equal(uint64(4), resp.Pagination.Total)
+	resp, err = suite.querier.Budgets(sdk.WrapSDKContext(suite.ctx), &types.QueryBudgetsRequest{
+		Tag:           "grants",
+		SourceAddress: suite.sourceAddrs[3].String(),
+		Pagination:    &query.PageRequest{Limit: 1},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{6}, ids(resp))
+	suite.Require().Nil(resp.Pagination.NextKey)
+
+	resp, err = suite.querier.Budgets(sdk.WrapSDKContext(suite.ctx), &types.QueryBudgetsRequest{
+		Tag:        "unknown",
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(resp.Budgets)
+	suite.Require().Zero(resp.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestGRPCCollectionHistory() {
+	for _, record := range []types.CollectionRecord{
+		{BudgetID: 1, Height: 1, CollectedCoins: mustParseCoinsNormalized("100denom1")},
+		{BudgetID: 1, Height: 2, CollectedCoins: mustParseCoinsNormalized("90denom1")},
+		{BudgetID: 1, Height: 3, CollectedCoins: mustParseCoinsNormalized("81denom1")},
+		{BudgetID: 2, Height: 2, CollectedCoins: mustParseCoinsNormalized("50denom1")},
+	} {
+		record.Time = types.MustParseRFC3339("2021-08-31T00:00:00Z")
+		suite.keeper.SetCollectionRecord(suite.ctx, record)
+	}
+
+	for _, tc := range []struct {
+		name      string
+		req       *types.QueryCollectionHistoryRequest
+		expectErr bool
+		postRun   func(*types.QueryCollectionHistoryResponse)
+	}{
+		{
+			"nil request",
+			nil,
+			true,
+			nil,
+		},
+		{
+			"zero budget id",
+			&types.QueryCollectionHistoryRequest{},
+			true,
+			nil,
+		},
+		{
+			"query by budget id",
+			&types.QueryCollectionHistoryRequest{BudgetId: 1},
+			false,
+			func(resp *types.QueryCollectionHistoryResponse) {
+				suite.Require().Len(resp.Records, 3)
+				for i, record := range resp.Records {
+					suite.Require().Equal(uint64(1), record.BudgetID)
+					suite.Require().Equal(int64(i+1), record.Height)
+				}
+				suite.Require().Equal(uint64(3), resp.Pagination.Total)
+			},
+		},
+		{
+			"query with pagination",
+			&types.QueryCollectionHistoryRequest{BudgetId: 1, Pagination: &query.PageRequest{Limit: 2, Reverse: true}},
+			false,
+			func(resp *types.QueryCollectionHistoryResponse) {
+				suite.Require().Len(resp.Records, 2)
+				suite.Require().Equal(int64(3), resp.Records[0].Height)
+				suite.Require().Equal(int64(2), resp.Records[1].Height)
+				suite.Require().NotNil(resp.Pagination.NextKey)
+			},
+		},
+		{
+			"query by budget id without records",
+			&types.QueryCollectionHistoryRequest{BudgetId: 3},
+			false,
+			func(resp *types.QueryCollectionHistoryResponse) {
+				suite.Require().Empty(resp.Records)
+			},
+		},
+	} {
+		suite.Run(tc.name, func() {
+			resp, err := suite.querier.CollectionHistory(sdk.WrapSDKContext(suite.ctx), tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				tc.postRun(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCProjections() {
+	suite.setBudgets(suite.budgets[0], suite.budgets[3])
+	ctx := suite.ctx.WithBlockHeight(10).WithBlockTime(types.MustParseRFC3339("2021-08-31T00:00:00Z"))
+
+	_, err := suite.querier.Projections(sdk.WrapSDKContext(ctx), nil)
+	suite.Require().Error(err)
+	_, err = suite.querier.Projections(sdk.WrapSDKContext(ctx), &types.QueryProjectionsRequest{BlockInterval: -time.Second})
+	suite.Require().Error(err)
+
+	resp, err := suite.querier.Projections(sdk.WrapSDKContext(ctx), &types.QueryProjectionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(resp.Projections, 1)
+	suite.Require().Equal(ctx.BlockHeight()+1, resp.Projections[0].NextCollectionHeight)
+	suite.Require().Equal(ctx.BlockTime().Add(keeper.DefaultProjectionBlockInterval), resp.Projections[0].EstimatedTime)
+	suite.Require().True(coinsEq(mustParseCoinsNormalized("500000000denom1,500000000denom2,500000000denom3,500000000stake"), resp.Projections[0].CollectedCoins))
+
+	resp, err = suite.querier.Projections(sdk.WrapSDKContext(ctx), &types.QueryProjectionsRequest{BlockInterval: time.Minute})
+	suite.Require().NoError(err)
+	suite.Require().Equal(ctx.BlockTime().Add(time.Minute), resp.Projections[0].EstimatedTime)
+}
+
 func (suite *KeeperTestSuite) TestGRPCAddresses() {
 	for _, tc := range []struct {
 		name         string
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+
+	"github.com/tendermint/budget/x/budget/types"
+)
+
+// SendIBCTransfer sends the balance of the IBC escrow account of the budget over the ICS-20 transfer of
+// the IBC transfer destination, with a packet for each denom. The balance includes the coins of the
+// transfers that failed and were parked in the escrow account. The coins of a packet that cannot be sent
+// are handled by the failure action of the transfer.
+func (k Keeper) SendIBCTransfer(ctx sdk.Context, budget types.Budget, transfer types.IBCTransfer) error {
+	escrowAcc := types.IBCEscrowAddress(budget.ID)
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(transfer.Timeout).UnixNano())
+	for _, coin := range k.bankKeeper.GetAllBalances(ctx, escrowAcc) {
+		// A transfer that fails to be sent, for example through a closed channel, must not change the state.
+		cacheCtx, writeCache := ctx.CacheContext()
+		err := k.transferKeeper.SendTransfer(
+			cacheCtx, transfer.SourcePort, transfer.SourceChannel, coin, escrowAcc, transfer.Receiver,
+			clienttypes.ZeroHeight(), timeoutTimestamp)
+		if err != nil {
+			k.Logger(ctx).Error("failed to send ibc transfer", "name", budget.Name, "channel", transfer.SourceChannel, "error", err)
+			if err := k.handleIBCTransferFailure(ctx, budget, &transfer, transfer.SourceChannel, coin, types.IBCFailureReasonSendFailed); err != nil {
+				return err
+			}
+			continue
+		}
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBudgetIBCTransferSent,
+				sdk.NewAttribute(types.AttributeValueName, budget.Name),
+				sdk.NewAttribute(types.AttributeValueSourceChannel, transfer.SourceChannel),
+				sdk.NewAttribute(types.AttributeValueReceiver, transfer.Receiver),
+				sdk.NewAttribute(types.AttributeValueAmount, coin.String()),
+			),
+		)
+	}
+	return nil
+}
+
+// OnIBCTransferAcknowledged handles the acknowledgement of an ICS-20 packet sent through the source channel.
+// It does nothing if the packet was not sent by the IBC escrow account of a budget. The coins of a failed
+// transfer, which the transfer module has refunded to the IBC escrow account, are handled as by
+// handleIBCTransferFailure.
+func (k Keeper) OnIBCTransferAcknowledged(
+	ctx sdk.Context, sourceChannel string, data transfertypes.FungibleTokenPacketData, success bool,
+) error {
+	budget, transfer, found := k.ibcTransferBudget(ctx, data.Sender)
+	if !found {
+		return nil
+	}
+	coin := ibcTransferCoin(data)
+	if !success {
+		return k.handleIBCTransferFailure(ctx, budget, transfer, sourceChannel, coin, types.IBCFailureReasonError)
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBudgetIBCTransferAcknowledged,
+			sdk.NewAttribute(types.AttributeValueName, budget.Name),
+			sdk.NewAttribute(types.AttributeValueSourceChannel, sourceChannel),
+			sdk.NewAttribute(types.AttributeValueReceiver, data.Receiver),
+			sdk.NewAttribute(types.AttributeValueAmount, coin.String()),
+		),
+	)
+	return nil
+}
+
+// OnIBCTransferTimedOut handles the timeout of an ICS-20 packet sent through the source channel. It does
+// nothing if the packet was not sent by the IBC escrow account of a budget. The coins, which the transfer
+// module has refunded to the IBC escrow account, are handled as by handleIBCTransferFailure.
+func (k Keeper) OnIBCTransferTimedOut(ctx sdk.Context, sourceChannel string, data transfertypes.FungibleTokenPacketData) error {
+	budget, transfer, found := k.ibcTransferBudget(ctx, data.Sender)
+	if !found {
+		return nil
+	}
+	return k.handleIBCTransferFailure(ctx, budget, transfer, sourceChannel, ibcTransferCoin(data), types.IBCFailureReasonTimeout)
+}
+
+// handleIBCTransferFailure refunds the coin in the IBC escrow account of the budget to the source address
+// of the budget, or leaves it parked in the escrow account, according to the failure action of the transfer.
+// The coin is always refunded if the transfer is nil, as the budget is archived or no longer has an IBC
+// transfer destination and the escrow account would never send it again.
+func (k Keeper) handleIBCTransferFailure(
+	ctx sdk.Context, budget types.Budget, transfer *types.IBCTransfer, sourceChannel string, coin sdk.Coin, reason string,
+) error {
+	eventType := types.EventTypeBudgetIBCTransferParked
+	if transfer == nil || transfer.OnFailure == types.IBCFailureActionRefund {
+		sourceAcc, err := k.ResolveAddress(budget.SourceAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoins(ctx, types.IBCEscrowAddress(budget.ID), sourceAcc, sdk.NewCoins(coin)); err != nil {
+			return err
+		}
+		eventType = types.EventTypeBudgetIBCTransferRefunded
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeValueName, budget.Name),
+			sdk.NewAttribute(types.AttributeValueSourceChannel, sourceChannel),
+			sdk.NewAttribute(types.AttributeValueAmount, coin.String()),
+			sdk.NewAttribute(types.AttributeValueReason, reason),
+		),
+	)
+	return nil
+}
+
+// refundIBCEscrow sends the balance of the IBC escrow account of the budget, which holds the parked coins
+// and the coins that could not be sent, back to the source address of the budget. It is used when the budget
+// is archived or loses its IBC transfer destination, as the escrow account would never send the balance again.
+func (k Keeper) refundIBCEscrow(ctx sdk.Context, budget types.Budget, transfer types.IBCTransfer, reason string) error {
+	escrowAcc := types.IBCEscrowAddress(budget.ID)
+	balances := k.bankKeeper.GetAllBalances(ctx, escrowAcc)
+	if balances.IsZero() {
+		return nil
+	}
+	sourceAcc, err := k.ResolveAddress(budget.SourceAddress)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, escrowAcc, sourceAcc, balances); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBudgetIBCTransferRefunded,
+			sdk.NewAttribute(types.AttributeValueName, budget.Name),
+			sdk.NewAttribute(types.AttributeValueSourceChannel, transfer.SourceChannel),
+			sdk.NewAttribute(types.AttributeValueAmount, balances.String()),
+			sdk.NewAttribute(types.AttributeValueReason, reason),
+		),
+	)
+	return nil
+}
+
+// ibcTransferBudget returns the budget whose IBC escrow account is the sender of an ICS-20 packet, and the
+// ICS-20 transfer of its IBC transfer destination. The budget is looked up by the index of the IBC escrow
+// addresses, which is kept for the archived budgets and the budgets that no longer have an IBC transfer
+// destination, since the packets sent before may be acknowledged after. The transfer is nil for them.
+func (k Keeper) ibcTransferBudget(ctx sdk.Context, sender string) (types.Budget, *types.IBCTransfer, bool) {
+	senderAcc, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return types.Budget{}, nil, false
+	}
+	id, found := k.GetBudgetIDByIBCEscrow(ctx, senderAcc)
+	if !found {
+		return types.Budget{}, nil, false
+	}
+	if budget, found := k.GetBudget(ctx, id); found {
+		if destination, ok := budget.IBCTransferDestination(); ok {
+			return budget, destination.IBCTransfer, true
+		}
+		return budget, nil, true
+	}
+	archived, found := k.GetArchivedBudget(ctx, id)
+	return archived.Budget, nil, found
+}
+
+// ibcTransferCoin returns the coin of an ICS-20 packet, with the denom on this chain.
+func ibcTransferCoin(data transfertypes.FungibleTokenPacketData) sdk.Coin {
+	return sdk.NewCoin(transfertypes.ParseDenomTrace(data.Denom).IBCDenom(), sdk.NewIntFromUint64(data.Amount))
+}
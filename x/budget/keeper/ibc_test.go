@@ -0,0 +1,186 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/testing"
+	"github.com/stretchr/testify/suite"
+
+	simapp "github.com/tendermint/budget/app"
+	"github.com/tendermint/budget/x/budget/types"
+)
+
+type IBCTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+	chainB      *ibctesting.TestChain
+	path        *ibctesting.Path
+}
+
+func TestIBCTestSuite(t *testing.T) {
+	ibctesting.DefaultTestingAppInit = func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		return simapp.SetupTestingApp()
+	}
+	suite.Run(t, new(IBCTestSuite))
+}
+
+func (suite *IBCTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(0))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	suite.coordinator.Setup(suite.path)
+}
+
+func (suite *IBCTestSuite) app(chain *ibctesting.TestChain) *simapp.BudgetApp {
+	return chain.App.(*simapp.BudgetApp)
+}
+
+// collect sets a budget that sends half of the source balance over the transfer channel of the path in
+// the next block, commits the block, and returns the packet sent by the budget.
+func (suite *IBCTestSuite) collect(receiver string, timeout time.Duration, onFailure types.IBCFailureAction) channeltypes.Packet {
+	budgetApp := suite.app(suite.chainA)
+	ctx := suite.chainA.GetContext()
+	sourceAcc := types.DeriveAddress(types.AddressType32Bytes, types.ModuleName, "sourceAddr1")
+	err := simapp.FundAccount(budgetApp.BankKeeper, ctx, sourceAcc, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000)))
+	suite.Require().NoError(err)
+
+	transfer := types.IBCTransfer{
+		SourcePort:    suite.path.EndpointA.ChannelConfig.PortID,
+		SourceChannel: suite.path.EndpointA.ChannelID,
+		Receiver:      receiver,
+		Timeout:       timeout,
+		OnFailure:     onFailure,
+	}
+	// The testing chains begin a block several times, and the recurrence makes the budget collect once.
+	budget := types.Budget{
+		Name:          "budget1",
+		Rate:          sdk.NewDecWithPrec(5, 1),
+		SourceAddress: sourceAcc.String(),
+		StartTime:     ctx.BlockTime(),
+		EndTime:       ctx.BlockTime().AddDate(1, 0, 0),
+		Recurrence:    &types.Recurrence{Type: types.RecurrenceTypeDaily},
+		Destinations:  []types.BudgetDestination{{Weight: sdk.OneDec(), Type: types.DestinationTypeIBCTransfer, IBCTransfer: &transfer}},
+	}
+	budgetApp.BudgetKeeper.AddBudget(ctx, budget)
+
+	// The budget collects in the begin blocker of the next block, whose time is the current time,
+	// and the block is committed so that the packet can be relayed.
+	blockTime := suite.chainA.CurrentHeader.Time
+	suite.coordinator.CommitNBlocks(suite.chainA, 2)
+
+	data := transfertypes.NewFungibleTokenPacketData(
+		sdk.DefaultBondDenom, 500_000, types.IBCEscrowAddress(1).String(), receiver)
+	return channeltypes.NewPacket(
+		data.GetBytes(), 1, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+		clienttypes.ZeroHeight(), uint64(blockTime.Add(timeout).UnixNano()))
+}
+
+func (suite *IBCTestSuite) balance(chain *ibctesting.TestChain, addr sdk.AccAddress, denom string) sdk.Int {
+	return suite.app(chain).BankKeeper.GetBalance(chain.GetContext(), addr, denom).Amount
+}
+
+// timeout lets the counterparty chain pass the timeout of the packet without receiving it, and relays the timeout.
+func (suite *IBCTestSuite) timeout(packet channeltypes.Packet) {
+	suite.coordinator.CommitBlock(suite.chainB)
+	err := suite.path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+	err = suite.path.EndpointA.TimeoutPacket(packet)
+	suite.Require().NoError(err)
+}
+
+func (suite *IBCTestSuite) TestIBCTransfer() {
+	receiver := suite.chainB.SenderAccount.GetAddress()
+	packet := suite.collect(receiver.String(), time.Hour, types.IBCFailureActionRefund)
+
+	escrowAcc := types.IBCEscrowAddress(1)
+	suite.Require().True(suite.balance(suite.chainA, escrowAcc, sdk.DefaultBondDenom).IsZero())
+
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	err := suite.path.RelayPacket(packet, ack.Acknowledgement())
+	suite.Require().NoError(err)
+
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom))
+	suite.Require().Equal(sdk.NewInt(500_000), suite.balance(suite.chainB, receiver, voucher.IBCDenom()))
+	suite.Require().True(suite.balance(suite.chainA, escrowAcc, sdk.DefaultBondDenom).IsZero())
+
+	collected := suite.app(suite.chainA).BudgetKeeper.GetDestinationCollectedCoins(suite.chainA.GetContext(), 1, escrowAcc)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500_000)), collected)
+}
+
+func (suite *IBCTestSuite) TestIBCTransferErrorRefunded() {
+	// The counterparty chain acknowledges the packet with an error as the receiver is invalid.
+	packet := suite.collect("invalid", time.Hour, types.IBCFailureActionRefund)
+
+	_, err := sdk.AccAddressFromBech32("invalid")
+	ack := channeltypes.NewErrorAcknowledgement(err.Error())
+	err = suite.path.RelayPacket(packet, ack.Acknowledgement())
+	suite.Require().NoError(err)
+
+	sourceAcc := types.DeriveAddress(types.AddressType32Bytes, types.ModuleName, "sourceAddr1")
+	suite.Require().Equal(sdk.NewInt(1_000_000), suite.balance(suite.chainA, sourceAcc, sdk.DefaultBondDenom))
+	suite.Require().True(suite.balance(suite.chainA, types.IBCEscrowAddress(1), sdk.DefaultBondDenom).IsZero())
+}
+
+func (suite *IBCTestSuite) TestIBCTransferTimeoutParked() {
+	packet := suite.collect(suite.chainB.SenderAccount.GetAddress().String(), time.Second, types.IBCFailureActionEscrow)
+	suite.timeout(packet)
+
+	sourceAcc := types.DeriveAddress(types.AddressType32Bytes, types.ModuleName, "sourceAddr1")
+	suite.Require().Equal(sdk.NewInt(500_000), suite.balance(suite.chainA, sourceAcc, sdk.DefaultBondDenom))
+	suite.Require().Equal(sdk.NewInt(500_000), suite.balance(suite.chainA, types.IBCEscrowAddress(1), sdk.DefaultBondDenom))
+}
+
+func (suite *IBCTestSuite) TestIBCTransferArchivedInFlight() {
+	packet := suite.collect(suite.chainB.SenderAccount.GetAddress().String(), time.Second, types.IBCFailureActionEscrow)
+
+	// The budget is archived while its packet is in flight, so the coins are refunded despite the failure action.
+	suite.app(suite.chainA).BudgetKeeper.ArchiveBudget(suite.chainA.GetContext(), 1, types.BudgetStatusRemoved)
+	suite.timeout(packet)
+
+	sourceAcc := types.DeriveAddress(types.AddressType32Bytes, types.ModuleName, "sourceAddr1")
+	suite.Require().Equal(sdk.NewInt(1_000_000), suite.balance(suite.chainA, sourceAcc, sdk.DefaultBondDenom))
+	suite.Require().True(suite.balance(suite.chainA, types.IBCEscrowAddress(1), sdk.DefaultBondDenom).IsZero())
+}
+
+func (suite *IBCTestSuite) TestIBCTransferParkedRefundedOnArchive() {
+	packet := suite.collect(suite.chainB.SenderAccount.GetAddress().String(), time.Second, types.IBCFailureActionEscrow)
+	suite.timeout(packet)
+
+	sourceAcc := types.DeriveAddress(types.AddressType32Bytes, types.ModuleName, "sourceAddr1")
+	suite.Require().Equal(sdk.NewInt(500_000), suite.balance(suite.chainA, types.IBCEscrowAddress(1), sdk.DefaultBondDenom))
+
+	suite.app(suite.chainA).BudgetKeeper.ArchiveBudget(suite.chainA.GetContext(), 1, types.BudgetStatusRemoved)
+	suite.Require().Equal(sdk.NewInt(1_000_000), suite.balance(suite.chainA, sourceAcc, sdk.DefaultBondDenom))
+	suite.Require().True(suite.balance(suite.chainA, types.IBCEscrowAddress(1), sdk.DefaultBondDenom).IsZero())
+}
+
+func (suite *IBCTestSuite) TestIBCTransferDestinationRemoved() {
+	packet := suite.collect(suite.chainB.SenderAccount.GetAddress().String(), time.Second, types.IBCFailureActionEscrow)
+	suite.timeout(packet)
+
+	sourceAcc := types.DeriveAddress(types.AddressType32Bytes, types.ModuleName, "sourceAddr1")
+	suite.Require().Equal(sdk.NewInt(500_000), suite.balance(suite.chainA, types.IBCEscrowAddress(1), sdk.DefaultBondDenom))
+
+	// The parked coins are refunded when the budget no longer has an ibc transfer destination.
+	budgetApp := suite.app(suite.chainA)
+	ctx := suite.chainA.GetContext()
+	budget, found := budgetApp.BudgetKeeper.GetBudget(ctx, 1)
+	suite.Require().True(found)
+	budget.Destinations = []types.BudgetDestination{{Weight: sdk.OneDec(), Type: types.DestinationTypeBurn}}
+	budgetApp.BudgetKeeper.UpdateBudget(ctx, budget)
+	suite.Require().Equal(sdk.NewInt(1_000_000), suite.balance(suite.chainA, sourceAcc, sdk.DefaultBondDenom))
+	suite.Require().True(suite.balance(suite.chainA, types.IBCEscrowAddress(1), sdk.DefaultBondDenom).IsZero())
+}
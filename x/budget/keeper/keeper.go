@@ -18,8 +18,11 @@ type Keeper struct {
 	cdc        codec.BinaryCodec
 	paramSpace paramtypes.Subspace
 
-	bankKeeper    types.BankKeeper
-	accountKeeper types.AccountKeeper
+	bankKeeper     types.BankKeeper
+	accountKeeper  types.AccountKeeper
+	stakingKeeper  types.StakingKeeper
+	distrKeeper    types.DistributionKeeper
+	transferKeeper types.TransferKeeper
 
 	blockedAddrs map[string]bool
 }
@@ -29,8 +32,8 @@ type Keeper struct {
 // - sending to and from ModuleAccounts
 // - minting, burning PoolCoins
 func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
-	accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper,
-	blockedAddrs map[string]bool,
+	accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, stakingKeeper types.StakingKeeper,
+	distrKeeper types.DistributionKeeper, transferKeeper types.TransferKeeper, blockedAddrs map[string]bool,
 ) Keeper {
 	// ensure budget module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -43,12 +46,15 @@ func NewKeeper(cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Su
 	}
 
 	return Keeper{
-		storeKey:      key,
-		cdc:           cdc,
-		paramSpace:    paramSpace,
-		accountKeeper: accountKeeper,
-		bankKeeper:    bankKeeper,
-		blockedAddrs:  blockedAddrs,
+		storeKey:       key,
+		cdc:            cdc,
+		paramSpace:     paramSpace,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
+		stakingKeeper:  stakingKeeper,
+		distrKeeper:    distrKeeper,
+		transferKeeper: transferKeeper,
+		blockedAddrs:   blockedAddrs,
 	}
 }
 
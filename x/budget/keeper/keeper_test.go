@@ -5,14 +5,13 @@ import (
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
-	"github.com/cosmos/cosmos-sdk/x/params"
-	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	simapp "github.com/tendermint/budget/app"
+	"github.com/tendermint/budget/x/budget"
 	"github.com/tendermint/budget/x/budget/keeper"
 	"github.com/tendermint/budget/x/budget/types"
 )
@@ -46,10 +45,6 @@ type KeeperTestSuite struct {
 	budgets          []types.Budget
 }
 
-func testProposal(changes ...proposal.ParamChange) *proposal.ParameterChangeProposal {
-	return proposal.NewParameterChangeProposal("title", "description", changes)
-}
-
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -57,7 +52,7 @@ func TestKeeperTestSuite(t *testing.T) {
 func (suite *KeeperTestSuite) SetupTest() {
 	suite.app = simapp.Setup(false)
 	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{})
-	suite.govHandler = params.NewParamChangeProposalHandler(suite.app.ParamsKeeper)
+	suite.govHandler = budget.NewBudgetProposalHandler(suite.app.BudgetKeeper)
 
 	suite.keeper = suite.app.BudgetKeeper
 	suite.querier = keeper.Querier{Keeper: suite.keeper}
@@ -89,7 +84,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 			Rate:               sdk.MustNewDecFromStr("0.5"),
 			SourceAddress:      suite.sourceAddrs[0].String(),
 			DestinationAddress: suite.destinationAddrs[0].String(),
-			StartTime:          types.MustParseRFC3339("0000-01-01T00:00:00Z"),
+			StartTime:          types.MustParseRFC3339("0001-01-01T00:00:00Z"),
 			EndTime:            types.MustParseRFC3339("9999-12-31T00:00:00Z"),
 		},
 		{
@@ -97,7 +92,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 			Rate:               sdk.MustNewDecFromStr("0.5"),
 			SourceAddress:      suite.sourceAddrs[0].String(),
 			DestinationAddress: suite.destinationAddrs[1].String(),
-			StartTime:          types.MustParseRFC3339("0000-01-01T00:00:00Z"),
+			StartTime:          types.MustParseRFC3339("0001-01-01T00:00:00Z"),
 			EndTime:            types.MustParseRFC3339("9999-12-31T00:00:00Z"),
 		},
 		{
@@ -105,7 +100,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 			Rate:               sdk.MustNewDecFromStr("1.0"),
 			SourceAddress:      suite.sourceAddrs[1].String(),
 			DestinationAddress: suite.destinationAddrs[2].String(),
-			StartTime:          types.MustParseRFC3339("0000-01-01T00:00:00Z"),
+			StartTime:          types.MustParseRFC3339("0001-01-01T00:00:00Z"),
 			EndTime:            types.MustParseRFC3339("9999-12-31T00:00:00Z"),
 		},
 		{
@@ -113,15 +108,15 @@ func (suite *KeeperTestSuite) SetupTest() {
 			Rate:               sdk.MustNewDecFromStr("1"),
 			SourceAddress:      suite.sourceAddrs[2].String(),
 			DestinationAddress: suite.destinationAddrs[3].String(),
-			StartTime:          types.MustParseRFC3339("0000-01-01T00:00:00Z"),
-			EndTime:            types.MustParseRFC3339("0000-01-02T00:00:00Z"),
+			StartTime:          types.MustParseRFC3339("0001-01-01T00:00:00Z"),
+			EndTime:            types.MustParseRFC3339("0001-01-02T00:00:00Z"),
 		},
 		{
 			Name:               "budget5",
 			Rate:               sdk.MustNewDecFromStr("0.5"),
 			SourceAddress:      suite.sourceAddrs[3].String(),
 			DestinationAddress: suite.destinationAddrs[0].String(),
-			StartTime:          types.MustParseRFC3339("0000-01-01T00:00:00Z"),
+			StartTime:          types.MustParseRFC3339("0001-01-01T00:00:00Z"),
 			EndTime:            types.MustParseRFC3339("9999-12-31T00:00:00Z"),
 		},
 		{
@@ -129,7 +124,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 			Rate:               sdk.MustNewDecFromStr("0.5"),
 			SourceAddress:      suite.sourceAddrs[3].String(),
 			DestinationAddress: suite.destinationAddrs[1].String(),
-			StartTime:          types.MustParseRFC3339("0000-01-01T00:00:00Z"),
+			StartTime:          types.MustParseRFC3339("0001-01-01T00:00:00Z"),
 			EndTime:            types.MustParseRFC3339("9999-12-31T00:00:00Z"),
 		},
 		{
@@ -143,6 +138,20 @@ func (suite *KeeperTestSuite) SetupTest() {
 	}
 }
 
+// setBudgets replaces the budgets in the store with the given budgets, assigning them ids in order.
+// It returns the budgets with their ids.
+func (suite *KeeperTestSuite) setBudgets(budgets ...types.Budget) []types.Budget {
+	for _, budget := range suite.keeper.GetAllBudgets(suite.ctx) {
+		suite.keeper.DeleteBudget(suite.ctx, budget.ID)
+	}
+	suite.keeper.SetLastBudgetID(suite.ctx, 0)
+	stored := make([]types.Budget, len(budgets))
+	for i, budget := range budgets {
+		stored[i] = suite.keeper.AddBudget(suite.ctx, budget)
+	}
+	return stored
+}
+
 func coinsEq(exp, got sdk.Coins) (bool, string, string, string) {
 	return exp.IsEqual(got), "expected:\t%v\ngot:\t\t%v", exp.String(), got.String()
 }
@@ -154,3 +163,7 @@ func mustParseCoinsNormalized(coinStr string) (coins sdk.Coins) {
 	}
 	return coins
 }
+
+func decPtr(dec sdk.Dec) *sdk.Dec {
+	return &dec
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/tendermint/budget/x/budget/legacy/v2"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper    Keeper
+	paramsKey sdk.StoreKey
+}
+
+// NewMigrator returns a new Migrator. The store key of the params module is needed to delete the
+// budgets param of v1, which is no longer registered in the key table.
+func NewMigrator(keeper Keeper, paramsKey sdk.StoreKey) Migrator {
+	return Migrator{keeper: keeper, paramsKey: paramsKey}
+}
+
+// Migrate1to2 migrates from version 1 to 2. The budgets moved to the store are indexed by their
+// addresses after the migration, since the addresses are resolved by the keeper.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := v2.MigrateStore(ctx, m.keeper.storeKey, m.paramsKey, m.keeper.paramSpace, m.keeper.cdc); err != nil {
+		return err
+	}
+	m.keeper.ReindexBudgets(ctx)
+	return nil
+}
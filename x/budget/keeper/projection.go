@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/budget/x/budget/types"
+)
+
+// DefaultProjectionBlockInterval is the expected time between blocks used to estimate the times of the
+// projected collections when it is not given.
+const DefaultProjectionBlockInterval = 6 * time.Second
+
+// NextCollectionHeight returns the height of the first block after the current block at which the budget is due
+// to collect, with blocks expected every blockInterval, or 0 if the budget is not due to collect with the current
+// params. The budget can still be skipped at the height, for example if it has ended or its conditions do not hold.
+func (k Keeper) NextCollectionHeight(ctx sdk.Context, budget types.Budget, blockInterval time.Duration) int64 {
+	params := k.GetParams(ctx)
+	switch params.EpochMode {
+	case types.EpochModeBlocks:
+		if params.EpochBlocks == 0 {
+			return 0
+		}
+	case types.EpochModeDuration:
+		if params.EpochDuration == 0 {
+			return 0
+		}
+	}
+
+	// blocksUntil returns the number of blocks after the current block until the first block at or after t.
+	blocksUntil := func(t time.Time) int64 {
+		blocks := int64((t.Sub(ctx.BlockTime()) + blockInterval - 1) / blockInterval)
+		if blocks < 1 {
+			return 1
+		}
+		return blocks
+	}
+
+	switch {
+	case budget.Recurrence != nil:
+		periodStart := budget.Recurrence.PeriodStart(budget.StartTime, k.GetNextPeriod(ctx, budget.ID))
+		return ctx.BlockHeight() + blocksUntil(periodStart)
+	case budget.EpochBlocks == 0 && params.EpochMode == types.EpochModeDuration:
+		lastEpochTime := k.GetLastEpochTime(ctx)
+		if lastEpochTime.IsZero() {
+			return ctx.BlockHeight() + 1
+		}
+		return ctx.BlockHeight() + blocksUntil(lastEpochTime.Add(params.EpochDuration))
+	default:
+		return budget.NextDueHeight(ctx.BlockHeight(), params.EpochBlocks, k.GetLastCollectedHeight(ctx, budget.ID))
+	}
+}
+
+// ProjectCollections returns the next collection of each active budget in order of id, as it would be collected
+// at its next collection height if the state does not change until then. The collections of each height are
+// calculated by collecting the budgets on a cached context at the height and its estimated time, so that they
+// are calculated exactly as CollectBudgets calculates them.
+func (k Keeper) ProjectCollections(ctx sdk.Context, blockInterval time.Duration) ([]types.BudgetProjection, error) {
+	projections := []types.BudgetProjection{}
+	var heights []int64
+	indexesByHeight := make(map[int64][]int)
+	for _, budget := range k.GetAllBudgets(ctx) {
+		if budget.Status(ctx.BlockTime(), ctx.BlockHeight()) != types.BudgetStatusActive {
+			continue
+		}
+
+		projection := types.BudgetProjection{
+			BudgetID:      budget.ID,
+			Name:          budget.Name,
+			SourceAddress: budget.SourceAddress,
+		}
+		if sourceAcc, err := k.ResolveAddress(budget.SourceAddress); err == nil {
+			projection.SourceAddress = sourceAcc.String()
+			projection.SourceBalances = k.bankKeeper.GetAllBalances(ctx, sourceAcc)
+		}
+		if height := k.NextCollectionHeight(ctx, budget, blockInterval); height > 0 {
+			projection.NextCollectionHeight = height
+			projection.EstimatedTime = ctx.BlockTime().Add(time.Duration(height-ctx.BlockHeight()) * blockInterval)
+			if _, ok := indexesByHeight[height]; !ok {
+				heights = append(heights, height)
+			}
+			indexesByHeight[height] = append(indexesByHeight[height], len(projections))
+		}
+		projections = append(projections, projection)
+	}
+
+	for _, height := range heights {
+		indexes := indexesByHeight[height]
+		cacheCtx, _ := ctx.CacheContext()
+		cacheCtx = cacheCtx.
+			WithBlockHeight(height).
+			WithBlockTime(projections[indexes[0]].EstimatedTime).
+			WithEventManager(sdk.NewEventManager())
+
+		collections := make(map[uint64]types.BudgetCollection)
+		if err := k.collectBudgets(cacheCtx, func(sourceCollections []types.BudgetCollection) {
+			for _, collection := range sourceCollections {
+				collections[collection.Budget.ID] = collection
+			}
+		}); err != nil {
+			return nil, err
+		}
+
+		for _, i := range indexes {
+			// The collections that are empty or invalid are not transferred by CollectBudgets.
+			collection, ok := collections[projections[i].BudgetID]
+			if !ok || collection.Coins.Empty() || !collection.Coins.IsValid() {
+				continue
+			}
+			projections[i].CollectedCoins = collection.Coins
+			for j, destination := range collection.Budget.CollectionDestinations() {
+				projections[i].DestinationCoins = append(projections[i].DestinationCoins, types.DestinationCollectedCoins{
+					DestinationAddress:  destination.Address,
+					TotalCollectedCoins: collection.DestinationCoins[j],
+				})
+			}
+		}
+	}
+	return projections, nil
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/tendermint/budget/x/budget/keeper"
+	"github.com/tendermint/budget/x/budget/types"
+)
+
+func (suite *KeeperTestSuite) TestNextCollectionHeight() {
+	ctx := suite.ctx.WithBlockHeight(12).WithBlockTime(types.MustParseRFC3339("2021-08-31T00:00:00Z"))
+	budget := suite.setBudgets(suite.budgets[0])[0]
+
+	params := suite.keeper.GetParams(ctx)
+	params.EpochBlocks = 5
+	suite.keeper.SetParams(ctx, params)
+	suite.Require().Equal(int64(15), suite.keeper.NextCollectionHeight(ctx, budget, time.Second))
+	suite.keeper.SetLastCollectedHeight(ctx, budget.ID, 12)
+	suite.Require().Equal(int64(20), suite.keeper.NextCollectionHeight(ctx, budget, time.Second))
+
+	// a recurring budget collects at the first block of its next period
+	daily := budget
+	daily.StartTime = types.MustParseRFC3339("2021-08-01T00:00:00Z")
+	daily.Recurrence = &types.Recurrence{Type: types.RecurrenceTypeDaily}
+	suite.keeper.SetNextPeriod(ctx, daily.ID, 31)
+	suite.Require().Equal(int64(12+14400), suite.keeper.NextCollectionHeight(ctx, daily, keeper.DefaultProjectionBlockInterval))
+	suite.Require().Equal(int64(12+24), suite.keeper.NextCollectionHeight(ctx, daily, time.Hour))
+	suite.keeper.SetNextPeriod(ctx, daily.ID, 30)
+	suite.Require().Equal(int64(13), suite.keeper.NextCollectionHeight(ctx, daily, time.Hour))
+
+	// a budget without its own epoch length collects at the first block at or after the next epoch boundary
+	params.EpochMode = types.EpochModeDuration
+	params.EpochDuration = time.Hour
+	suite.keeper.SetParams(ctx, params)
+	suite.Require().Equal(int64(13), suite.keeper.NextCollectionHeight(ctx, budget, time.Second))
+	suite.keeper.SetLastEpochTime(ctx, ctx.BlockTime().Add(-30*time.Minute))
+	suite.Require().Equal(int64(12+300), suite.keeper.NextCollectionHeight(ctx, budget, keeper.DefaultProjectionBlockInterval))
+	suite.Require().Equal(int64(12+5), suite.keeper.NextCollectionHeight(ctx, budget, 7*time.Minute))
+
+	params.EpochDuration = 0
+	suite.keeper.SetParams(ctx, params)
+	suite.Require().Zero(suite.keeper.NextCollectionHeight(ctx, budget, time.Second))
+}
+
+func (suite *KeeperTestSuite) TestProjectCollections() {
+	ctx := suite.ctx.WithBlockHeight(12).WithBlockTime(types.MustParseRFC3339("2021-08-31T00:00:00Z"))
+	budgets := suite.setBudgets(suite.budgets[0], suite.budgets[1], suite.budgets[2], suite.budgets[3], suite.budgets[6])
+
+	params := suite.keeper.GetParams(ctx)
+	params.EpochBlocks = 5
+	suite.keeper.SetParams(ctx, params)
+	suite.keeper.SetLastCollectedHeight(ctx, budgets[2].ID, 12)
+
+	// only the active budgets are projected, and the state is not changed
+	projections, err := suite.keeper.ProjectCollections(ctx, keeper.DefaultProjectionBlockInterval)
+	suite.Require().NoError(err)
+	suite.Require().Len(projections, 3)
+	suite.Require().True(suite.keeper.GetTotalCollectedCoins(ctx, budgets[0].ID).Empty())
+	suite.Require().Zero(suite.keeper.GetLastCollectedHeight(ctx, budgets[0].ID))
+
+	for i, height := range []int64{15, 15, 20} {
+		projection := projections[i]
+		suite.Require().Equal(budgets[i].ID, projection.BudgetID)
+		suite.Require().Equal(budgets[i].Name, projection.Name)
+		suite.Require().Equal(height, projection.NextCollectionHeight)
+		suite.Require().Equal(ctx.BlockTime().Add(time.Duration(height-12)*keeper.DefaultProjectionBlockInterval), projection.EstimatedTime)
+		suite.Require().Equal(budgets[i].SourceAddress, projection.SourceAddress)
+		suite.Require().True(coinsEq(suite.app.BankKeeper.GetAllBalances(ctx, suite.sourceAddrs[i/2]), projection.SourceBalances))
+		suite.Require().Len(projection.DestinationCoins, 1)
+		suite.Require().Equal(budgets[i].DestinationAddress, projection.DestinationCoins[0].DestinationAddress)
+		suite.Require().True(coinsEq(projection.CollectedCoins, projection.DestinationCoins[0].TotalCollectedCoins))
+	}
+
+	// the budgets collect the projected coins at the projected heights
+	for _, projection := range []types.BudgetProjection{projections[0], projections[2]} {
+		ctx = ctx.WithBlockHeight(projection.NextCollectionHeight).WithBlockTime(projection.EstimatedTime)
+		err = suite.keeper.CollectBudgets(ctx)
+		suite.Require().NoError(err)
+	}
+	for i, projection := range projections {
+		suite.Require().False(projection.CollectedCoins.Empty())
+		suite.Require().True(coinsEq(projection.CollectedCoins, suite.keeper.GetTotalCollectedCoins(ctx, budgets[i].ID)))
+	}
+
+	// the budgets whose source has been emptied are projected to collect nothing
+	projections, err = suite.keeper.ProjectCollections(ctx, keeper.DefaultProjectionBlockInterval)
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(25), projections[0].NextCollectionHeight)
+	suite.Require().True(projections[0].SourceBalances.IsZero())
+	suite.Require().True(projections[0].CollectedCoins.Empty())
+	suite.Require().Empty(projections[0].DestinationCoins)
+}
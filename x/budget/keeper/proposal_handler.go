@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/budget/x/budget/types"
+)
+
+// HandleBudgetProposal is a handler for executing a budget proposal.
+// The budgets to delete, update and add are applied in order, and the resulting budgets are
+// validated as a whole before any of them is written to the store. The deleted budgets are archived,
+// and the updated budgets unwind the staking and IBC transfer destinations they lose, see UpdateBudget.
+func HandleBudgetProposal(ctx sdk.Context, k Keeper, p *types.BudgetProposal) error {
+	budgets := k.GetAllBudgets(ctx)
+	indexes := make(map[uint64]int)
+	for i, budget := range budgets {
+		indexes[budget.ID] = i
+	}
+
+	deleted := make(map[uint64]bool)
+	for _, id := range p.DeleteBudgetIDs {
+		if _, ok := indexes[id]; !ok {
+			return sdkerrors.Wrapf(types.ErrBudgetNotFound, "budget %d not found", id)
+		}
+		deleted[id] = true
+	}
+
+	for _, budget := range p.UpdateBudgets {
+		i, ok := indexes[budget.ID]
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrBudgetNotFound, "budget %d not found", budget.ID)
+		}
+		budgets[i] = budget
+	}
+
+	var newBudgets []types.Budget
+	for _, budget := range budgets {
+		if !deleted[budget.ID] {
+			newBudgets = append(newBudgets, budget)
+		}
+	}
+	newBudgets = append(newBudgets, p.AddBudgets...)
+
+	if err := types.ValidateBudgets(newBudgets); err != nil {
+		return err
+	}
+	for _, budget := range newBudgets {
+		if _, err := k.ResolveBudget(budget); err != nil {
+			return err
+		}
+		if err := k.ValidateStakingBudget(ctx, budget); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range p.DeleteBudgetIDs {
+		k.ArchiveBudget(ctx, id, types.BudgetStatusRemoved)
+	}
+	for _, budget := range p.UpdateBudgets {
+		k.UpdateBudget(ctx, budget)
+	}
+	for _, budget := range p.AddBudgets {
+		k.AddBudget(ctx, budget)
+	}
+
+	return nil
+}
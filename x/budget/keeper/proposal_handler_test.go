@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+
+	"github.com/tendermint/budget/app"
+	"github.com/tendermint/budget/x/budget/types"
+)
+
+func (suite *KeeperTestSuite) TestHandleBudgetProposal() {
+	budgets := suite.setBudgets(suite.budgets[0], suite.budgets[1])
+
+	// the budgets are deleted, updated and added together
+	updated := budgets[1]
+	updated.Rate = sdk.MustNewDecFromStr("0.3")
+	proposal := types.NewBudgetProposal("title", "description",
+		[]types.Budget{suite.budgets[2]}, []types.Budget{updated}, []uint64{budgets[0].ID})
+	suite.Require().NoError(proposal.ValidateBasic())
+	err := suite.govHandler(suite.ctx, proposal)
+	suite.Require().NoError(err)
+
+	added := suite.budgets[2]
+	added.ID = 3
+	suite.Require().Equal([]types.Budget{updated, added}, suite.keeper.GetAllBudgets(suite.ctx))
+
+	// the deleted budget is archived
+	archived, found := suite.keeper.GetArchivedBudget(suite.ctx, budgets[0].ID)
+	suite.Require().True(found)
+	suite.Require().Equal(budgets[0], archived.Budget)
+	suite.Require().Equal(types.BudgetStatusRemoved, archived.Status)
+
+	exceeding := suite.budgets[0]
+	exceeding.Rate = sdk.OneDec()
+
+	staking := suite.budgets[4]
+	staking.DestinationAddress = ""
+	staking.AllowedDenoms = []string{denom1}
+	staking.Destinations = []types.BudgetDestination{{
+		Weight:     sdk.OneDec(),
+		Type:       types.DestinationTypeStaking,
+		Validators: []string{sdk.ValAddress(suite.addrs[0]).String()},
+	}}
+
+	for _, tc := range []struct {
+		name        string
+		proposal    *types.BudgetProposal
+		expectedErr error
+	}{
+		{
+			"update not existing budget",
+			types.NewBudgetProposal("title", "description", nil, []types.Budget{budgets[0]}, nil),
+			types.ErrBudgetNotFound,
+		},
+		{
+			"delete not existing budget",
+			types.NewBudgetProposal("title", "description", nil, nil, []uint64{4}),
+			types.ErrBudgetNotFound,
+		},
+		{
+			"delete archived budget",
+			types.NewBudgetProposal("title", "description", nil, nil, []uint64{budgets[0].ID}),
+			types.ErrBudgetNotFound,
+		},
+		{
+			"exceed total rate",
+			types.NewBudgetProposal("title", "description", []types.Budget{exceeding}, nil, nil),
+			types.ErrInvalidTotalBudgetRate,
+		},
+		{
+			"duplicate budget name",
+			types.NewBudgetProposal("title", "description", []types.Budget{suite.budgets[2]}, nil, []uint64{2}),
+			types.ErrDuplicateBudgetName,
+		},
+		{
+			"staking budget of not the bond denom",
+			types.NewBudgetProposal("title", "description", []types.Budget{staking}, nil, nil),
+			types.ErrInvalidBudgetDestinations,
+		},
+	} {
+		suite.Run(tc.name, func() {
+			err := suite.govHandler(suite.ctx, tc.proposal)
+			suite.Require().ErrorIs(err, tc.expectedErr)
+
+			// a failed proposal does not change any budget
+			suite.Require().Equal([]types.Budget{updated, added}, suite.keeper.GetAllBudgets(suite.ctx))
+			suite.Require().Equal(uint64(3), suite.keeper.GetLastBudgetID(suite.ctx))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestHandleBudgetProposalStaking() {
+	stakingHelper := teststaking.NewHelper(suite.T(), suite.ctx, suite.app.StakingKeeper)
+	valAddrs := app.ConvertAddrsToValAddrs(suite.addrs[:2])
+	pks := app.CreateTestPubKeys(2)
+	for i, valAddr := range valAddrs {
+		stakingHelper.CreateValidator(valAddr, pks[i], sdk.NewInt(1_000_000), true)
+	}
+
+	budget := suite.budgets[0]
+	budget.Rate = sdk.NewDecWithPrec(1, 1)
+	budget.DestinationAddress = ""
+	budget.AllowedDenoms = []string{sdk.DefaultBondDenom}
+	budget.Destinations = []types.BudgetDestination{{
+		Weight:     sdk.OneDec(),
+		Type:       types.DestinationTypeStaking,
+		Validators: []string{valAddrs[0].String(), valAddrs[1].String()},
+	}}
+	budget = suite.setBudgets(budget)[0]
+
+	sourceBalances := suite.app.BankKeeper.GetAllBalances(suite.ctx, suite.sourceAddrs[0])
+	err := suite.keeper.CollectBudgets(suite.ctx)
+	suite.Require().NoError(err)
+	delegatorAcc := types.DelegatorAddress(budget.ID)
+	suite.Require().Len(suite.app.StakingKeeper.GetDelegatorDelegations(suite.ctx, delegatorAcc, 10), 2)
+
+	// the delegation to the validator removed from the staking destination is undelegated
+	budget.Destinations[0].Validators = []string{valAddrs[1].String()}
+	err = suite.govHandler(suite.ctx, types.NewBudgetProposal("title", "description", nil, []types.Budget{budget}, nil))
+	suite.Require().NoError(err)
+	_, found := suite.app.StakingKeeper.GetDelegation(suite.ctx, delegatorAcc, valAddrs[0])
+	suite.Require().False(found)
+	_, found = suite.app.StakingKeeper.GetDelegation(suite.ctx, delegatorAcc, valAddrs[1])
+	suite.Require().True(found)
+	suite.Require().False(suite.keeper.IsUnbondingBudget(suite.ctx, budget.ID))
+
+	// the delegations of the budget that loses its staking destination are undelegated, and the budget is
+	// released once they are unbonded
+	budget.Destinations = nil
+	budget.DestinationAddress = suite.destinationAddrs[0].String()
+	err = suite.govHandler(suite.ctx, types.NewBudgetProposal("title", "description", nil, []types.Budget{budget}, nil))
+	suite.Require().NoError(err)
+	suite.Require().Empty(suite.app.StakingKeeper.GetDelegatorDelegations(suite.ctx, delegatorAcc, 10))
+	suite.Require().True(suite.keeper.IsUnbondingBudget(suite.ctx, budget.ID))
+
+	ctx := suite.ctx
+	for _, valAddr := range valAddrs {
+		ubd, found := suite.app.StakingKeeper.GetUnbondingDelegation(ctx, delegatorAcc, valAddr)
+		suite.Require().True(found)
+		ctx = ctx.WithBlockTime(ubd.Entries[0].CompletionTime)
+		_, err = suite.app.StakingKeeper.CompleteUnbonding(ctx, delegatorAcc, valAddr)
+		suite.Require().NoError(err)
+	}
+	suite.Require().NoError(suite.keeper.ReleaseUnbondedBudgets(ctx))
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(ctx, delegatorAcc).Empty())
+	suite.Require().True(coinsEq(sourceBalances, suite.app.BankKeeper.GetAllBalances(ctx, suite.sourceAddrs[0])))
+	suite.Require().False(suite.keeper.IsUnbondingBudget(ctx, budget.ID))
+}
@@ -0,0 +1,114 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/tendermint/budget/x/budget/types"
+)
+
+// KeyBudgets is the key of the budgets parameter of v1.
+var KeyBudgets = []byte("Budgets")
+
+// MigrateStore performs in-place store migrations from v1 to v2. The migration includes:
+//
+//   - Moving the budgets from the params to the module store, assigning them ids in order, and
+//     deleting the budgets param from the params store.
+//   - Re-keying the total collected coins of the budgets, the only records of v1, by their ids instead
+//     of their names. The records of budgets that were removed from the params get ids after the ones
+//     of the budgets, and are moved to the archive as removed budgets.
+//   - Setting the lifecycle statuses of the budgets that have not expired, so that no event is emitted
+//     for the budgets that had already started.
+//   - Clamping the start and end times of the budgets to the times that can be stored, which does not
+//     change when the budgets are collectible.
+//   - Setting the params added since v1 to their default values.
+//
+// The delegator accounts and the IBC escrow accounts of the budgets are derived from their ids, and
+// there are no such accounts to move since the budgets of v1 only have destination addresses.
+func MigrateStore(
+	ctx sdk.Context, storeKey, paramsKey sdk.StoreKey, paramSpace paramtypes.Subspace, cdc codec.BinaryCodec,
+) error {
+	var budgets []types.Budget
+	if bz := paramSpace.GetRaw(ctx, KeyBudgets); bz != nil {
+		if err := codec.NewLegacyAmino().UnmarshalJSON(bz, &budgets); err != nil {
+			return err
+		}
+	}
+
+	var lastBudgetID uint64
+	ids := make(map[string]uint64)
+	budgetID := func(name string) uint64 {
+		if id, ok := ids[name]; ok {
+			return id
+		}
+		lastBudgetID++
+		ids[name] = lastBudgetID
+		return lastBudgetID
+	}
+	for i, budget := range budgets {
+		budgets[i].ID = budgetID(budget.Name)
+		if budget.HasTimeRange() {
+			if budget.StartTime.Before(types.MinBudgetTime) {
+				budgets[i].StartTime = types.MinBudgetTime
+			}
+			if budget.EndTime.After(types.MaxBudgetTime) {
+				budgets[i].EndTime = types.MaxBudgetTime
+			}
+		}
+	}
+
+	// Only the total collected coins of the budgets were recorded in v1, keyed by the names of the budgets.
+	store := ctx.KVStore(storeKey)
+	type pair struct {
+		name  string
+		value []byte
+	}
+	var pairs []pair
+	iterator := sdk.KVStorePrefixIterator(store, types.TotalCollectedCoinsKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		pairs = append(pairs, pair{string(iterator.Key()[1:]), iterator.Value()})
+	}
+	iterator.Close()
+
+	// The records of the budgets that were removed are moved to the archive as removed budgets.
+	for _, p := range pairs {
+		store.Delete(append(types.TotalCollectedCoinsKeyPrefix, p.name...))
+		id := budgetID(p.name)
+		if id <= uint64(len(budgets)) {
+			store.Set(types.GetTotalCollectedCoinsKey(id), p.value)
+			continue
+		}
+		var collected types.TotalCollectedCoins
+		cdc.MustUnmarshal(p.value, &collected)
+		store.Set(types.GetArchivedBudgetKey(id), cdc.MustMarshal(&types.ArchivedBudget{
+			Budget:              types.Budget{ID: id, Name: p.name},
+			Status:              types.BudgetStatusRemoved,
+			ArchivedHeight:      ctx.BlockHeight(),
+			ArchivedTime:        ctx.BlockTime(),
+			TotalCollectedCoins: collected.TotalCollectedCoins,
+		}))
+	}
+
+	for _, budget := range budgets {
+		store.Set(types.GetBudgetKey(budget.ID), cdc.MustMarshal(&budget))
+		store.Set(types.GetBudgetByNameIndexKey(budget.Name), sdk.Uint64ToBigEndian(budget.ID))
+		// The budgets that have expired are archived at the next begin block.
+		if status := budget.Status(ctx.BlockTime(), ctx.BlockHeight()); status != types.BudgetStatusExpired {
+			store.Set(types.GetBudgetStatusKey(budget.ID), sdk.Uint64ToBigEndian(uint64(status)))
+		}
+	}
+	store.Set(types.LastBudgetIDKey, sdk.Uint64ToBigEndian(lastBudgetID))
+
+	prefix.NewStore(ctx.KVStore(paramsKey), []byte(types.ModuleName+"/")).Delete(KeyBudgets)
+
+	defaultParams := types.DefaultParams()
+	for _, paramSetPair := range defaultParams.ParamSetPairs() {
+		if !paramSpace.Has(ctx, paramSetPair.Key) {
+			paramSpace.Set(ctx, paramSetPair.Key, paramSetPair.Value)
+		}
+	}
+
+	return nil
+}
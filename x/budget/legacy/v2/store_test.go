@@ -0,0 +1,115 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	v2 "github.com/tendermint/budget/x/budget/legacy/v2"
+	"github.com/tendermint/budget/x/budget/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	budgetKey := sdk.NewKVStoreKey(types.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tparamsKey := sdk.NewTransientStoreKey(paramtypes.TStoreKey)
+
+	cms := store.NewCommitMultiStore(dbm.NewMemDB())
+	cms.MountStoreWithDB(budgetKey, sdk.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(tparamsKey, sdk.StoreTypeTransient, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := sdk.NewContext(cms, tmproto.Header{Time: types.MustParseRFC3339("2021-10-01T00:00:00Z")}, false, log.NewNopLogger())
+
+	paramSpace := paramtypes.NewSubspace(encCfg.Marshaler, encCfg.Amino, paramsKey, tparamsKey, types.ModuleName).
+		WithKeyTable(types.ParamKeyTable())
+
+	sourceAcc := sdk.AccAddress(address.Module(types.ModuleName, []byte("sourceAddr1")))
+	destinationAcc := sdk.AccAddress(address.Module(types.ModuleName, []byte("destinationAddr1")))
+	budgets := []types.Budget{
+		{
+			Name:               "budget1",
+			Rate:               sdk.NewDecWithPrec(5, 1),
+			SourceAddress:      sourceAcc.String(),
+			DestinationAddress: destinationAcc.String(),
+			StartTime:          types.MustParseRFC3339("0000-01-01T00:00:00Z"),
+			EndTime:            types.MustParseRFC3339("9999-12-31T00:00:00Z"),
+		},
+		{
+			Name:               "budget2",
+			Rate:               sdk.NewDecWithPrec(5, 1),
+			SourceAddress:      sourceAcc.String(),
+			DestinationAddress: destinationAcc.String(),
+			StartTime:          types.MustParseRFC3339("2021-08-01T00:00:00Z"),
+			EndTime:            types.MustParseRFC3339("2021-09-01T00:00:00Z"),
+		},
+	}
+
+	// The params of v1 are stored as amino JSON, and only the epoch blocks and the budgets existed.
+	paramsStore := prefix.NewStore(ctx.KVStore(paramsKey), []byte(types.ModuleName+"/"))
+	paramsStore.Set(types.KeyEpochBlocks, codec.NewLegacyAmino().MustMarshalJSON(uint32(5)))
+	paramsStore.Set(v2.KeyBudgets, codec.NewLegacyAmino().MustMarshalJSON(budgets))
+
+	// The records of v1 are keyed by the names of the budgets, and the removed budget3 still has a record.
+	collected := types.TotalCollectedCoins{TotalCollectedCoins: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))}
+	budgetStore := ctx.KVStore(budgetKey)
+	budgetStore.Set(append(types.TotalCollectedCoinsKeyPrefix, "budget2"...), encCfg.Marshaler.MustMarshal(&collected))
+	budgetStore.Set(append(types.TotalCollectedCoinsKeyPrefix, "budget3"...), encCfg.Marshaler.MustMarshal(&collected))
+
+	err := v2.MigrateStore(ctx, budgetKey, paramsKey, paramSpace, encCfg.Marshaler)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(3), sdk.BigEndianToUint64(budgetStore.Get(types.LastBudgetIDKey)))
+	for i, expected := range budgets {
+		var budget types.Budget
+		encCfg.Marshaler.MustUnmarshal(budgetStore.Get(types.GetBudgetKey(uint64(i+1))), &budget)
+		require.Equal(t, uint64(i+1), budget.ID)
+		require.Equal(t, expected.Name, budget.Name)
+		require.Equal(t, uint64(i+1), sdk.BigEndianToUint64(budgetStore.Get(types.GetBudgetByNameIndexKey(expected.Name))))
+	}
+
+	// the start time that cannot be stored is clamped
+	var budget1 types.Budget
+	encCfg.Marshaler.MustUnmarshal(budgetStore.Get(types.GetBudgetKey(1)), &budget1)
+	require.Equal(t, types.MinBudgetTime, budget1.StartTime)
+
+	require.Equal(t, encCfg.Marshaler.MustMarshal(&collected), budgetStore.Get(types.GetTotalCollectedCoinsKey(2)))
+	require.Nil(t, budgetStore.Get(types.GetTotalCollectedCoinsKey(3)))
+
+	// the record of the removed budget is moved to the archive
+	var archived types.ArchivedBudget
+	encCfg.Marshaler.MustUnmarshal(budgetStore.Get(types.GetArchivedBudgetKey(3)), &archived)
+	require.Equal(t, uint64(3), archived.Budget.ID)
+	require.Equal(t, "budget3", archived.Budget.Name)
+	require.Equal(t, types.BudgetStatusRemoved, archived.Status)
+	require.Equal(t, collected.TotalCollectedCoins, archived.TotalCollectedCoins)
+
+	// the status of budget1 is set, and budget2, which has expired, is left to be archived at the next begin block
+	require.Equal(t, uint64(types.BudgetStatusActive), sdk.BigEndianToUint64(budgetStore.Get(types.GetBudgetStatusKey(1))))
+	require.Nil(t, budgetStore.Get(types.GetBudgetStatusKey(2)))
+	require.Nil(t, budgetStore.Get(append(types.TotalCollectedCoinsKeyPrefix, "budget2"...)))
+
+	// the budgets param is deleted
+	require.False(t, paramsStore.Has(v2.KeyBudgets))
+
+	// the params added since v1 are set to their default values
+	var params types.Params
+	paramSpace.GetParamSet(ctx, &params)
+	require.Equal(t, uint32(5), params.EpochBlocks)
+	require.Empty(t, params.SourceProcessingModes)
+	require.Empty(t, params.SourceReserves)
+	require.Equal(t, types.EpochModeBlocks, params.EpochMode)
+	require.Zero(t, params.EpochDuration)
+	require.Equal(t, types.DefaultHistoryRetentionBlocks, params.HistoryRetentionBlocks)
+}
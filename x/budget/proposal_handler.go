@@ -0,0 +1,24 @@
+package budget
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/tendermint/budget/x/budget/keeper"
+	"github.com/tendermint/budget/x/budget/types"
+)
+
+// NewBudgetProposalHandler creates a governance handler to manage new proposal types.
+// It enables BudgetProposal to add, update and delete budgets.
+func NewBudgetProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.BudgetProposal:
+			return keeper.HandleBudgetProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized budget proposal content type: %T", c)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/tendermint/budget/x/budget/types"
@@ -21,6 +22,71 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &cB)
 			return fmt.Sprintf("%v\n%v", cA, cB)
 
+		case bytes.Equal(kvA.Key[:1], types.DestinationCollectedCoinsKeyPrefix):
+			var cA, cB types.TotalCollectedCoins
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.NextPeriodKeyPrefix):
+			return fmt.Sprintf("%v\n%v", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.RemainderKeyPrefix):
+			var rA, rB types.Remainder
+			cdc.MustUnmarshal(kvA.Value, &rA)
+			cdc.MustUnmarshal(kvB.Value, &rB)
+			return fmt.Sprintf("%v\n%v", rA, rB)
+
+		case bytes.Equal(kvA.Key[:1], types.TotalBurnedCoinsKey):
+			var cA, cB types.TotalBurnedCoins
+			cdc.MustUnmarshal(kvA.Value, &cA)
+			cdc.MustUnmarshal(kvB.Value, &cB)
+			return fmt.Sprintf("%v\n%v", cA, cB)
+
+		case bytes.Equal(kvA.Key[:1], types.LastCollectedHeightKeyPrefix):
+			return fmt.Sprintf("%v\n%v", int64(sdk.BigEndianToUint64(kvA.Value)), int64(sdk.BigEndianToUint64(kvB.Value)))
+
+		case bytes.Equal(kvA.Key[:1], types.LastEpochTimeKey):
+			tA, _ := sdk.ParseTimeBytes(kvA.Value)
+			tB, _ := sdk.ParseTimeBytes(kvB.Value)
+			return fmt.Sprintf("%v\n%v", tA, tB)
+
+		case bytes.Equal(kvA.Key[:1], types.LastBudgetIDKey):
+			return fmt.Sprintf("%v\n%v", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.BudgetKeyPrefix):
+			var bA, bB types.Budget
+			cdc.MustUnmarshal(kvA.Value, &bA)
+			cdc.MustUnmarshal(kvB.Value, &bB)
+			return fmt.Sprintf("%v\n%v", bA, bB)
+
+		case bytes.Equal(kvA.Key[:1], types.BudgetByNameIndexKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.BudgetByIBCEscrowIndexKeyPrefix):
+			return fmt.Sprintf("%v\n%v", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.CollectionRecordKeyPrefix):
+			var rA, rB types.CollectionRecord
+			cdc.MustUnmarshal(kvA.Value, &rA)
+			cdc.MustUnmarshal(kvB.Value, &rB)
+			return fmt.Sprintf("%v\n%v", rA, rB)
+
+		case bytes.Equal(kvA.Key[:1], types.CollectionRecordByHeightIndexKeyPrefix):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.BudgetStatusKeyPrefix):
+			return fmt.Sprintf("%v\n%v", types.BudgetStatus(sdk.BigEndianToUint64(kvA.Value)), types.BudgetStatus(sdk.BigEndianToUint64(kvB.Value)))
+
+		case bytes.Equal(kvA.Key[:1], types.ArchivedBudgetKeyPrefix):
+			var aA, aB types.ArchivedBudget
+			cdc.MustUnmarshal(kvA.Value, &aA)
+			cdc.MustUnmarshal(kvB.Value, &aB)
+			return fmt.Sprintf("%v\n%v", aA, aB)
+
+		case bytes.Equal(kvA.Key[:1], types.BudgetBySourceIndexKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.BudgetByDestinationIndexKeyPrefix),
+			bytes.Equal(kvA.Key[:1], types.UnbondingBudgetIndexKeyPrefix):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
 		default:
 			panic(fmt.Sprintf("invalid budget key prefix %X", kvA.Key[:1]))
 		}
@@ -22,9 +22,58 @@ func TestDecodeBudgetStore(t *testing.T) {
 		TotalCollectedCoins: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(1000000))),
 	}
 
+	r := types.Remainder{
+		Remainder: sdk.NewDecCoins(sdk.NewDecCoinFromDec("test", sdk.NewDecWithPrec(5, 1))),
+	}
+
+	b := types.TotalBurnedCoins{
+		TotalBurnedCoins: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(1000))),
+	}
+
+	epochTime := types.MustParseRFC3339("2021-08-01T00:00:00Z")
+
+	budget := types.Budget{
+		ID:   1,
+		Name: "budget1",
+		Rate: sdk.NewDecWithPrec(5, 1),
+	}
+
+	record := types.CollectionRecord{
+		BudgetID:       1,
+		Height:         10,
+		Time:           epochTime,
+		SourceBalances: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(2000))),
+		CollectedCoins: sdk.NewCoins(sdk.NewCoin("test", sdk.NewInt(1000))),
+	}
+
+	archived := types.ArchivedBudget{
+		Budget:              budget,
+		Status:              types.BudgetStatusRemoved,
+		ArchivedHeight:      10,
+		ArchivedTime:        epochTime,
+		TotalCollectedCoins: tc.TotalCollectedCoins,
+	}
+
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.TotalCollectedCoinsKeyPrefix, Value: cdc.Marshaler.MustMarshal(&tc)},
+			{Key: types.DestinationCollectedCoinsKeyPrefix, Value: cdc.Marshaler.MustMarshal(&tc)},
+			{Key: types.NextPeriodKeyPrefix, Value: sdk.Uint64ToBigEndian(3)},
+			{Key: types.RemainderKeyPrefix, Value: cdc.Marshaler.MustMarshal(&r)},
+			{Key: types.TotalBurnedCoinsKey, Value: cdc.Marshaler.MustMarshal(&b)},
+			{Key: types.LastCollectedHeightKeyPrefix, Value: sdk.Uint64ToBigEndian(10)},
+			{Key: types.LastEpochTimeKey, Value: sdk.FormatTimeBytes(epochTime)},
+			{Key: types.LastBudgetIDKey, Value: sdk.Uint64ToBigEndian(2)},
+			{Key: types.BudgetKeyPrefix, Value: cdc.Marshaler.MustMarshal(&budget)},
+			{Key: types.BudgetByNameIndexKeyPrefix, Value: sdk.Uint64ToBigEndian(1)},
+			{Key: types.CollectionRecordKeyPrefix, Value: cdc.Marshaler.MustMarshal(&record)},
+			{Key: types.CollectionRecordByHeightIndexKeyPrefix, Value: []byte{}},
+			{Key: types.BudgetStatusKeyPrefix, Value: sdk.Uint64ToBigEndian(uint64(types.BudgetStatusActive))},
+			{Key: types.ArchivedBudgetKeyPrefix, Value: cdc.Marshaler.MustMarshal(&archived)},
+			{Key: types.BudgetBySourceIndexKeyPrefix, Value: []byte{}},
+			{Key: types.BudgetByDestinationIndexKeyPrefix, Value: []byte{}},
+			{Key: types.UnbondingBudgetIndexKeyPrefix, Value: []byte{}},
+			{Key: types.BudgetByIBCEscrowIndexKeyPrefix, Value: sdk.Uint64ToBigEndian(1)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -34,6 +83,23 @@ func TestDecodeBudgetStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"totalCollectedCoins", fmt.Sprintf("%v\n%v", tc, tc)},
+		{"destinationCollectedCoins", fmt.Sprintf("%v\n%v", tc, tc)},
+		{"nextPeriod", "3\n3"},
+		{"remainder", fmt.Sprintf("%v\n%v", r, r)},
+		{"totalBurnedCoins", fmt.Sprintf("%v\n%v", b, b)},
+		{"lastCollectedHeight", "10\n10"},
+		{"lastEpochTime", fmt.Sprintf("%v\n%v", epochTime, epochTime)},
+		{"lastBudgetID", "2\n2"},
+		{"budget", fmt.Sprintf("%v\n%v", budget, budget)},
+		{"budgetByNameIndex", "1\n1"},
+		{"collectionRecord", fmt.Sprintf("%v\n%v", record, record)},
+		{"collectionRecordByHeightIndex", "[]\n[]"},
+		{"budgetStatus", "BUDGET_STATUS_ACTIVE\nBUDGET_STATUS_ACTIVE"},
+		{"archivedBudget", fmt.Sprintf("%v\n%v", archived, archived)},
+		{"budgetBySourceIndex", "[]\n[]"},
+		{"budgetByDestinationIndex", "[]\n[]"},
+		{"unbondingBudgetIndex", "[]\n[]"},
+		{"budgetByIBCEscrowIndex", "1\n1"},
 		{"other", ""},
 	}
 	for i, tt := range tests {
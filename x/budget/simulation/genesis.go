@@ -26,12 +26,13 @@ func GenEpochBlocks(r *rand.Rand) uint32 {
 	return uint32(simtypes.RandIntBetween(r, int(types.DefaultEpochBlocks), 10))
 }
 
-// GenBudgets returns randomized budgets.
+// GenBudgets returns randomized budgets with ids assigned in order.
 func GenBudgets(r *rand.Rand) []types.Budget {
 	ranBudgets := make([]types.Budget, 0)
 
 	for i := 0; i < simtypes.RandIntBetween(r, 1, 3); i++ {
 		budget := types.Budget{
+			ID:                 uint64(i + 1),
 			Name:               "simulation-test-" + simtypes.RandStringOfLength(r, 5),
 			Rate:               sdk.NewDecFromIntWithPrec(sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, 4))), 1), // 10~30%
 			SourceAddress:      "cosmos17xpfvakm2amg962yls6f84z3kell8c5lserqta",                                   // Cosmos Hub's FeeCollector module account
@@ -62,8 +63,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 	budgetGenesis := types.GenesisState{
 		Params: types.Params{
 			EpochBlocks: epochBlocks,
-			Budgets:     budgets,
 		},
+		Budgets:      budgets,
+		LastBudgetID: uint64(len(budgets)),
 	}
 
 	bz, _ := json.MarshalIndent(&budgetGenesis, "", " ")
@@ -40,9 +40,11 @@ func TestRandomizedGenState(t *testing.T) {
 	var genState types.GenesisState
 	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &genState)
 
-	require.Equal(t, sdk.MustNewDecFromStr("0.3"), genState.Params.Budgets[0].Rate)
-	require.Equal(t, "cosmos17xpfvakm2amg962yls6f84z3kell8c5lserqta", genState.Params.Budgets[0].SourceAddress)
-	require.Equal(t, "cosmos1ke7rn6vl3vmeasmcrxdm3pfrt37fsg5jfrex80pp3hvhwgu4h4usxgvk3e", genState.Params.Budgets[0].DestinationAddress)
+	require.Equal(t, uint64(1), genState.Budgets[0].ID)
+	require.Equal(t, uint64(len(genState.Budgets)), genState.LastBudgetID)
+	require.Equal(t, sdk.MustNewDecFromStr("0.3"), genState.Budgets[0].Rate)
+	require.Equal(t, "cosmos17xpfvakm2amg962yls6f84z3kell8c5lserqta", genState.Budgets[0].SourceAddress)
+	require.Equal(t, "cosmos1ke7rn6vl3vmeasmcrxdm3pfrt37fsg5jfrex80pp3hvhwgu4h4usxgvk3e", genState.Budgets[0].DestinationAddress)
 	require.Equal(t, uint32(9), genState.Params.EpochBlocks)
 }
 
@@ -3,7 +3,6 @@ package simulation
 // DONTCOVER
 
 import (
-	"encoding/json"
 	"fmt"
 	"math/rand"
 
@@ -22,14 +21,5 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("%d", GenEpochBlocks(r))
 			},
 		),
-		simulation.NewSimParamChange(types.ModuleName, string(types.KeyBudgets),
-			func(r *rand.Rand) string {
-				bz, err := json.Marshal(GenBudgets(r))
-				if err != nil {
-					panic(err)
-				}
-				return string(bz)
-			},
-		),
 	}
 }
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Prefixes of the address references that can be used instead of bech32 addresses.
+const (
+	ModuleAddressReferencePrefix  = "module:"
+	DerivedAddressReferencePrefix = "derived:"
+)
+
+// ParseModuleReference returns the module name of the module address reference, module:<module name>.
+// It returns false if the address is not a module address reference.
+func ParseModuleReference(address string) (string, bool) {
+	if !strings.HasPrefix(address, ModuleAddressReferencePrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(address, ModuleAddressReferencePrefix), true
+}
+
+// ResolveAddress resolves the bech32 address or the address reference to an account address.
+// A module address reference, module:<module name>, resolves to the module account address of the module.
+// A derived address reference, derived:<address type>:<module name>:<name>, resolves to the address
+// derived by DeriveAddress, where the address type is the name of the AddressType.
+func ResolveAddress(address string) (sdk.AccAddress, error) {
+	if moduleName, ok := ParseModuleReference(address); ok {
+		if moduleName == "" {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "empty module name: %s", address)
+		}
+		return authtypes.NewModuleAddress(moduleName), nil
+	}
+	if strings.HasPrefix(address, DerivedAddressReferencePrefix) {
+		parts := strings.SplitN(strings.TrimPrefix(address, DerivedAddressReferencePrefix), ":", 3)
+		if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid derived address reference: %s", address)
+		}
+		addressType, ok := AddressType_value[parts[0]]
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "unknown address type %s", parts[0])
+		}
+		return DeriveAddress(AddressType(addressType), parts[1], parts[2]), nil
+	}
+	return sdk.AccAddressFromBech32(address)
+}
+
+// resolvedAddressKey returns the bech32 string of the resolved address, or the address itself if
+// it cannot be resolved. It is used to compare addresses given as address references.
+func resolvedAddressKey(address string) string {
+	addr, err := ResolveAddress(address)
+	if err != nil {
+		return address
+	}
+	return addr.String()
+}
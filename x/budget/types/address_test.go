@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/budget/x/budget/types"
+)
+
+func TestResolveAddress(t *testing.T) {
+	for _, tc := range []struct {
+		address  string
+		expected sdk.AccAddress
+	}{
+		{sAddr1.String(), sAddr1},
+		{"module:fee_collector", authtypes.NewModuleAddress(authtypes.FeeCollectorName)},
+		{"derived:ADDRESS_TYPE_32_BYTES:budget:sourceAddr1", sAddr1},
+		{"derived:ADDRESS_TYPE_20_BYTES:farming:name:with:colons", types.DeriveAddress(types.AddressType20Bytes, "farming", "name:with:colons")},
+	} {
+		addr, err := types.ResolveAddress(tc.address)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, addr)
+	}
+
+	for _, address := range []string{
+		"",
+		"invalid",
+		"module:",
+		"derived:ADDRESS_TYPE_32_BYTES:budget",
+		"derived:ADDRESS_TYPE_64_BYTES:budget:sourceAddr1",
+	} {
+		_, err := types.ResolveAddress(address)
+		require.Error(t, err, address)
+	}
+}
+
+func TestValidateBudgetsAddressReferences(t *testing.T) {
+	budget := budgets[0]
+	budget.SourceAddress = "derived:ADDRESS_TYPE_32_BYTES:budget:sourceAddr1"
+	budget.DestinationAddress = "module:fee_collector"
+	require.NoError(t, budget.Validate())
+	require.True(t, budget.HasDestination(authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()))
+
+	// budgets with the source given as a reference and as a bech32 address share the total rate
+	otherBudget := budgets[0]
+	otherBudget.Name = "other-budget"
+	otherBudget.Rate = sdk.NewDecWithPrec(6, 1)
+	require.Equal(t, sAddr1.String(), otherBudget.SourceAddress)
+	err := types.ValidateBudgets([]types.Budget{budget, otherBudget})
+	require.ErrorIs(t, err, types.ErrInvalidTotalBudgetRate)
+}
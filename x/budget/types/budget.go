@@ -1,19 +1,27 @@
 package types
 
 import (
+	"encoding/hex"
 	"fmt"
+	"net/url"
 	"regexp"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
 )
 
 var (
 	reBudgetNameString = fmt.Sprintf(`[a-zA-Z][a-zA-Z0-9-]{0,%d}`, MaxBudgetNameLength-1)
 	reBudgetName       = regexp.MustCompile(fmt.Sprintf(`^%s$`, reBudgetNameString))
+
+	// maxTime is used as the end time of a budget without a time range.
+	maxTime = time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC)
 )
 
 // String returns a human-readable string representation of the budget.
@@ -37,36 +45,565 @@ func (budget Budget) Validate() error {
 		return err
 	}
 
-	if _, err := sdk.AccAddressFromBech32(budget.DestinationAddress); err != nil {
-		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid destination address %s: %v", budget.DestinationAddress, err)
+	if err := budget.validateMetadata(); err != nil {
+		return err
 	}
 
-	if _, err := sdk.AccAddressFromBech32(budget.SourceAddress); err != nil {
+	if len(budget.Destinations) == 0 {
+		if _, err := ResolveAddress(budget.DestinationAddress); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid destination address %s: %v", budget.DestinationAddress, err)
+		}
+	} else if err := budget.validateDestinations(); err != nil {
+		return err
+	}
+
+	if _, err := ResolveAddress(budget.SourceAddress); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid source address %s: %v", budget.SourceAddress, err)
 	}
 
-	if !budget.EndTime.After(budget.StartTime) {
-		return ErrInvalidStartEndTime
+	if budget.HasTimeRange() {
+		if !budget.EndTime.After(budget.StartTime) {
+			return ErrInvalidStartEndTime
+		}
+		if budget.StartTime.Before(MinBudgetTime) || budget.EndTime.After(MaxBudgetTime) {
+			return sdkerrors.Wrapf(ErrInvalidStartEndTime, "budget times must be between %s and %s",
+				MinBudgetTime.Format(time.RFC3339), MaxBudgetTime.Format(time.RFC3339))
+		}
+	} else if budget.EndHeight == 0 {
+		return sdkerrors.Wrap(ErrInvalidStartEndTime, "budget must have an end time or an end height")
+	}
+
+	if budget.StartHeight < 0 || budget.EndHeight < 0 {
+		return sdkerrors.Wrapf(ErrInvalidStartEndHeight, "budget heights must not be negative: %d, %d", budget.StartHeight, budget.EndHeight)
+	}
+	if budget.EndHeight > 0 && budget.EndHeight <= budget.StartHeight {
+		return ErrInvalidStartEndHeight
+	}
+
+	switch budget.Type {
+	case BudgetTypeRate:
+		if budget.Rate.IsNil() || budget.Rate.IsNegative() {
+			return sdkerrors.Wrapf(ErrInvalidBudgetRate, "budget rate must not be negative: %s", budget.Rate)
+		} else if budget.Rate.GT(sdk.OneDec()) {
+			return sdkerrors.Wrapf(ErrInvalidBudgetRate, "budget rate must not exceed 1: %s", budget.Rate)
+		} else if budget.Rate.IsZero() && len(budget.DenomRates) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidBudgetRate, "budget rate must be positive: %s", budget.Rate)
+		}
+		if !budget.Amount.Empty() {
+			return sdkerrors.Wrapf(ErrInvalidBudgetAmount, "rate budget must not have an amount: %s", budget.Amount)
+		}
+		if err := budget.validateDenoms(); err != nil {
+			return err
+		}
+	case BudgetTypeFixedAmount:
+		if !budget.Rate.IsNil() && !budget.Rate.IsZero() {
+			return sdkerrors.Wrapf(ErrInvalidBudgetRate, "fixed amount budget must not have a rate: %s", budget.Rate)
+		}
+		if err := budget.Amount.Validate(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidBudgetAmount, "invalid budget amount %s: %v", budget.Amount, err)
+		}
+		if budget.Amount.Empty() {
+			return sdkerrors.Wrap(ErrInvalidBudgetAmount, "fixed amount budget must have a positive amount")
+		}
+		if len(budget.DenomRates) > 0 || len(budget.AllowedDenoms) > 0 || len(budget.DeniedDenoms) > 0 {
+			return sdkerrors.Wrap(ErrInvalidBudgetDenoms, "fixed amount budget must not have denom rates or denom lists")
+		}
+		if !budget.MaxEpochAmount.Empty() || !budget.MinEpochAmount.Empty() {
+			return sdkerrors.Wrap(ErrInvalidBudgetCap, "fixed amount budget must not have epoch amount caps")
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidBudgetType, "unknown budget type: %s", budget.Type)
+	}
+
+	if err := budget.validateCaps(); err != nil {
+		return err
+	}
+
+	// Only the bond denom is delegated, so that a budget with a staking destination must collect a single denom.
+	if _, ok := budget.StakingDestination(); ok {
+		if _, ok := budget.SingleDenom(); !ok {
+			return sdkerrors.Wrap(ErrInvalidBudgetDestinations, "budget with a staking destination must collect a single denom")
+		}
+	}
+
+	if budget.Schedule != nil {
+		if err := budget.Schedule.Validate(); err != nil {
+			return err
+		}
+		if budget.Schedule.Type == ScheduleTypeExponentialDecay || budget.Schedule.Type == ScheduleTypeHalving {
+			if !budget.HasTimeRange() {
+				return sdkerrors.Wrapf(ErrInvalidRateSchedule, "budget must have a start time for %s", budget.Schedule.Type)
+			}
+		}
+	}
+
+	if budget.Recurrence != nil {
+		if err := budget.Recurrence.Validate(); err != nil {
+			return err
+		}
+		if !budget.HasTimeRange() {
+			return sdkerrors.Wrap(ErrInvalidRecurrence, "budget must have a start time for a recurrence")
+		}
+		if budget.EpochBlocks != 0 || budget.EpochOffset != 0 {
+			return sdkerrors.Wrap(ErrInvalidEpoch, "budget with a recurrence must not have an epoch")
+		}
+	}
+
+	if budget.EpochBlocks != 0 && budget.EpochOffset >= budget.EpochBlocks {
+		return sdkerrors.Wrapf(ErrInvalidEpoch, "epoch offset %d must be less than epoch blocks %d", budget.EpochOffset, budget.EpochBlocks)
+	}
+
+	if err := budget.Reserve.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidReserve, "invalid reserve: %v", err)
+	}
+
+	if budget.Conditions != nil {
+		if err := budget.Conditions.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateMetadata validates the description, the link, the owner, and the tags of the budget.
+func (budget Budget) validateMetadata() error {
+	if len(budget.Description) > MaxBudgetDescriptionLength {
+		return sdkerrors.Wrapf(ErrInvalidBudgetMetadata, "description must not be longer than %d", MaxBudgetDescriptionLength)
+	}
+	if len(budget.Owner) > MaxBudgetOwnerLength {
+		return sdkerrors.Wrapf(ErrInvalidBudgetMetadata, "owner must not be longer than %d", MaxBudgetOwnerLength)
+	}
+
+	if budget.Link != "" {
+		if len(budget.Link) > MaxBudgetLinkLength {
+			return sdkerrors.Wrapf(ErrInvalidBudgetMetadata, "link must not be longer than %d", MaxBudgetLinkLength)
+		}
+		if u, err := url.Parse(budget.Link); err != nil || !u.IsAbs() {
+			return sdkerrors.Wrapf(ErrInvalidBudgetMetadata, "link %s must be an absolute URL", budget.Link)
+		}
+	}
+	if budget.LinkHash != "" {
+		if budget.Link == "" {
+			return sdkerrors.Wrap(ErrInvalidBudgetMetadata, "link hash must not be set without a link")
+		}
+		if bz, err := hex.DecodeString(budget.LinkHash); err != nil || len(bz) != 32 {
+			return sdkerrors.Wrapf(ErrInvalidBudgetMetadata, "link hash %s must be a hex-encoded SHA-256 hash", budget.LinkHash)
+		}
+	}
+
+	if len(budget.Tags) > MaxBudgetTags {
+		return sdkerrors.Wrapf(ErrInvalidBudgetMetadata, "budget must not have more than %d tags", MaxBudgetTags)
+	}
+	tags := make(map[string]bool)
+	for _, tag := range budget.Tags {
+		if strings.TrimSpace(tag) != tag || tag == "" || len(tag) > MaxBudgetTagLength {
+			return sdkerrors.Wrapf(ErrInvalidBudgetMetadata, "tag %q must not be blank, be padded with spaces, or be longer than %d", tag, MaxBudgetTagLength)
+		}
+		if tags[tag] {
+			return sdkerrors.Wrapf(ErrInvalidBudgetMetadata, "duplicate tag %s", tag)
+		}
+		tags[tag] = true
+	}
+	return nil
+}
+
+// validateCaps validates the lifetime cap and the epoch amount caps of the budget.
+func (budget Budget) validateCaps() error {
+	for _, c := range []struct {
+		name  string
+		coins sdk.Coins
+	}{
+		{CapLifetime, budget.LifetimeCap},
+		{CapMaxEpochAmount, budget.MaxEpochAmount},
+		{CapMinEpochAmount, budget.MinEpochAmount},
+	} {
+		if err := c.coins.Validate(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidBudgetCap, "invalid %s %s: %v", c.name, c.coins, err)
+		}
+	}
+	for _, coin := range budget.MinEpochAmount {
+		if !budget.DenomRate(coin.Denom).IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidBudgetCap, "min epoch amount denom %s is not collected by the budget", coin.Denom)
+		}
+		if maxAmount := budget.MaxEpochAmount.AmountOf(coin.Denom); maxAmount.IsPositive() && coin.Amount.GT(maxAmount) {
+			return sdkerrors.Wrapf(ErrInvalidBudgetCap, "min epoch amount %s must not exceed max epoch amount %s", coin, maxAmount)
+		}
+	}
+	return nil
+}
+
+// Due returns true if the budget collects at the block height, given the epoch length of the params and the height
+// at which the budget last collected. The epoch offset is taken modulo the epoch length, and a budget collects at
+// most once in its epoch length, so that changing the epoch length never makes it collect early.
+func (budget Budget) Due(height int64, defaultEpochBlocks uint32, lastCollectedHeight int64) bool {
+	epochBlocks := int64(budget.EpochBlocks)
+	if epochBlocks == 0 {
+		epochBlocks = int64(defaultEpochBlocks)
+	}
+	if epochBlocks == 0 || height%epochBlocks != int64(budget.EpochOffset)%epochBlocks {
+		return false
+	}
+	return lastCollectedHeight == 0 || height-lastCollectedHeight >= epochBlocks
+}
+
+// NextDueHeight returns the first height after the given height at which the budget is due to collect as by Due,
+// or 0 if the budget has no epoch length.
+func (budget Budget) NextDueHeight(height int64, defaultEpochBlocks uint32, lastCollectedHeight int64) int64 {
+	epochBlocks := int64(budget.EpochBlocks)
+	if epochBlocks == 0 {
+		epochBlocks = int64(defaultEpochBlocks)
+	}
+	if epochBlocks == 0 {
+		return 0
+	}
+	next := height + 1
+	next += ((int64(budget.EpochOffset)-next)%epochBlocks + epochBlocks) % epochBlocks
+	for lastCollectedHeight != 0 && next-lastCollectedHeight < epochBlocks {
+		next += epochBlocks
+	}
+	return next
+}
+
+// Exhausted returns true if the budget has a lifetime cap and the given total collected coins reached it.
+func (budget Budget) Exhausted(totalCollectedCoins sdk.Coins) bool {
+	return !budget.LifetimeCap.Empty() && totalCollectedCoins.IsAllGTE(budget.LifetimeCap)
+}
+
+// validateDestinations validates the weighted destinations of the budget.
+func (budget Budget) validateDestinations() error {
+	if budget.DestinationAddress != "" {
+		return sdkerrors.Wrap(ErrInvalidBudgetDestinations, "destination address must be empty when destinations are set")
+	}
+	addrs := make(map[string]bool)
+	for _, destination := range budget.Destinations {
+		// Destinations that are not accounts are identified by their type.
+		key := destination.Type.String()
+		switch destination.Type {
+		case DestinationTypeAccount:
+			if _, err := ResolveAddress(destination.Address); err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid destination address %s: %v", destination.Address, err)
+			}
+			key = resolvedAddressKey(destination.Address)
+		case DestinationTypeCommunityPool, DestinationTypeBurn:
+			if destination.Address != "" {
+				return sdkerrors.Wrapf(ErrInvalidBudgetDestinations, "address must be empty for %s", destination.Type)
+			}
+		case DestinationTypeStaking:
+			if destination.Address != "" {
+				return sdkerrors.Wrapf(ErrInvalidBudgetDestinations, "address must be empty for %s", destination.Type)
+			}
+			if err := destination.validateStaking(); err != nil {
+				return err
+			}
+		case DestinationTypeIBCTransfer:
+			if destination.Address != "" {
+				return sdkerrors.Wrapf(ErrInvalidBudgetDestinations, "address must be empty for %s", destination.Type)
+			}
+			if destination.IBCTransfer == nil {
+				return sdkerrors.Wrapf(ErrInvalidBudgetDestinations, "ibc transfer must be set for %s", destination.Type)
+			}
+			if err := destination.IBCTransfer.Validate(); err != nil {
+				return err
+			}
+		default:
+			return sdkerrors.Wrapf(ErrInvalidBudgetDestinations, "unknown destination type %s", destination.Type)
+		}
+		if destination.Type != DestinationTypeStaking && (len(destination.Validators) > 0 || destination.RewardsAddress != "") {
+			return sdkerrors.Wrapf(ErrInvalidBudgetDestinations, "validators and rewards address must be empty for %s", destination.Type)
+		}
+		if destination.Type != DestinationTypeIBCTransfer && destination.IBCTransfer != nil {
+			return sdkerrors.Wrapf(ErrInvalidBudgetDestinations, "ibc transfer must be empty for %s", destination.Type)
+		}
+		if addrs[key] {
+			return sdkerrors.Wrapf(ErrInvalidBudgetDestinations, "duplicate destination %s", key)
+		}
+		if destination.Weight.IsNil() || !destination.Weight.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidBudgetDestinations, "weight of destination %s must be positive: %s", key, destination.Weight)
+		}
+		addrs[key] = true
+	}
+	return nil
+}
+
+// validateStaking validates the validators and the rewards address of the staking destination.
+func (destination BudgetDestination) validateStaking() error {
+	if len(destination.Validators) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidBudgetDestinations, "validators must not be empty for %s", destination.Type)
+	}
+	validators := make(map[string]bool)
+	for _, validator := range destination.Validators {
+		if _, err := sdk.ValAddressFromBech32(validator); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address %s: %v", validator, err)
+		}
+		if validators[validator] {
+			return sdkerrors.Wrapf(ErrInvalidBudgetDestinations, "duplicate validator %s", validator)
+		}
+		validators[validator] = true
+	}
+	if destination.RewardsAddress != "" {
+		if _, err := ResolveAddress(destination.RewardsAddress); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid rewards address %s: %v", destination.RewardsAddress, err)
+		}
+	}
+	return nil
+}
+
+// Validate validates the ICS-20 transfer of an IBC transfer destination.
+func (transfer IBCTransfer) Validate() error {
+	if err := host.PortIdentifierValidator(transfer.SourcePort); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidBudgetDestinations, "invalid source port %s: %v", transfer.SourcePort, err)
+	}
+	if err := host.ChannelIdentifierValidator(transfer.SourceChannel); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidBudgetDestinations, "invalid source channel %s: %v", transfer.SourceChannel, err)
+	}
+	if strings.TrimSpace(transfer.Receiver) == "" {
+		return sdkerrors.Wrap(ErrInvalidBudgetDestinations, "receiver must not be empty")
 	}
+	if transfer.Timeout <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidBudgetDestinations, "timeout must be positive: %s", transfer.Timeout)
+	}
+	if _, ok := IBCFailureAction_name[int32(transfer.OnFailure)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidBudgetDestinations, "unknown failure action %s", transfer.OnFailure)
+	}
+	return nil
+}
 
-	if !budget.Rate.IsPositive() {
-		return sdkerrors.Wrapf(ErrInvalidBudgetRate, "budget rate must not be positive: %s", budget.Rate)
-	} else if budget.Rate.GT(sdk.OneDec()) {
-		return sdkerrors.Wrapf(ErrInvalidBudgetRate, "budget rate must not exceed 1: %s", budget.Rate)
+// validateDenoms validates the denom rates and the allowed and denied denoms of the budget.
+func (budget Budget) validateDenoms() error {
+	allowed := make(map[string]bool)
+	for _, denom := range budget.AllowedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidBudgetDenoms, "invalid allowed denom %s: %v", denom, err)
+		}
+		if allowed[denom] {
+			return sdkerrors.Wrapf(ErrInvalidBudgetDenoms, "duplicate allowed denom %s", denom)
+		}
+		allowed[denom] = true
+	}
+
+	denied := make(map[string]bool)
+	for _, denom := range budget.DeniedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidBudgetDenoms, "invalid denied denom %s: %v", denom, err)
+		}
+		if denied[denom] {
+			return sdkerrors.Wrapf(ErrInvalidBudgetDenoms, "duplicate denied denom %s", denom)
+		}
+		if allowed[denom] {
+			return sdkerrors.Wrapf(ErrInvalidBudgetDenoms, "denom %s is both allowed and denied", denom)
+		}
+		denied[denom] = true
+	}
+
+	rated := make(map[string]bool)
+	for _, denomRate := range budget.DenomRates {
+		if err := sdk.ValidateDenom(denomRate.Denom); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidBudgetDenoms, "invalid denom rate denom %s: %v", denomRate.Denom, err)
+		}
+		if rated[denomRate.Denom] {
+			return sdkerrors.Wrapf(ErrInvalidBudgetDenoms, "duplicate denom rate denom %s", denomRate.Denom)
+		}
+		if denied[denomRate.Denom] || len(allowed) > 0 && !allowed[denomRate.Denom] {
+			return sdkerrors.Wrapf(ErrInvalidBudgetDenoms, "denom rate denom %s is not collected by the budget", denomRate.Denom)
+		}
+		if denomRate.Rate.IsNil() || !denomRate.Rate.IsPositive() {
+			return sdkerrors.Wrapf(ErrInvalidBudgetRate, "denom rate of %s must be positive: %s", denomRate.Denom, denomRate.Rate)
+		} else if denomRate.Rate.GT(sdk.OneDec()) {
+			return sdkerrors.Wrapf(ErrInvalidBudgetRate, "denom rate of %s must not exceed 1: %s", denomRate.Denom, denomRate.Rate)
+		}
+		rated[denomRate.Denom] = true
 	}
 
 	return nil
 }
 
-// Collectible validates the budget has reached its start time and that the end time has not elapsed.
-func (budget Budget) Collectible(blockTime time.Time) bool {
-	return !budget.StartTime.After(blockTime) && budget.EndTime.After(blockTime)
+// CollectionDestinations returns the destinations that split the coins collected by the budget.
+// A budget with a destination address has it as its only destination.
+func (budget Budget) CollectionDestinations() []BudgetDestination {
+	if len(budget.Destinations) == 0 {
+		return []BudgetDestination{{Address: budget.DestinationAddress, Weight: sdk.OneDec()}}
+	}
+	return budget.Destinations
+}
+
+// HasDestination returns true if the address is one of the destinations of the budget.
+// Address references are compared by the addresses they resolve to.
+func (budget Budget) HasDestination(addr string) bool {
+	addr = resolvedAddressKey(addr)
+	for _, destination := range budget.CollectionDestinations() {
+		if resolvedAddressKey(destination.Address) == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// StakingDestination returns the staking destination of the budget if it has one.
+func (budget Budget) StakingDestination() (BudgetDestination, bool) {
+	for _, destination := range budget.Destinations {
+		if destination.Type == DestinationTypeStaking {
+			return destination, true
+		}
+	}
+	return BudgetDestination{}, false
+}
+
+// IBCTransferDestination returns the IBC transfer destination of the budget if it has one.
+func (budget Budget) IBCTransferDestination() (BudgetDestination, bool) {
+	for _, destination := range budget.Destinations {
+		if destination.Type == DestinationTypeIBCTransfer {
+			return destination, true
+		}
+	}
+	return BudgetDestination{}, false
+}
+
+// SingleDenom returns the denom that the budget collects if it can collect only a single denom.
+// A budget of the rate type collects only the denoms it allows or the denoms of its denom rates
+// when its default rate is zero.
+func (budget Budget) SingleDenom() (string, bool) {
+	var denoms []string
+	switch budget.Type {
+	case BudgetTypeRate:
+		candidates := budget.AllowedDenoms
+		if len(candidates) == 0 {
+			if budget.CollectionRate().IsPositive() {
+				return "", false
+			}
+			for _, denomRate := range budget.DenomRates {
+				candidates = append(candidates, denomRate.Denom)
+			}
+		}
+		for _, denom := range candidates {
+			if budget.DenomRate(denom).IsPositive() {
+				denoms = append(denoms, denom)
+			}
+		}
+	case BudgetTypeFixedAmount:
+		for _, coin := range budget.Amount {
+			denoms = append(denoms, coin.Denom)
+		}
+	}
+	if len(denoms) != 1 {
+		return "", false
+	}
+	return denoms[0], true
+}
+
+// HasTag returns true if the budget has the tag.
+func (budget Budget) HasTag(tag string) bool {
+	for _, t := range budget.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// CollectionRate returns the default rate of the source balance that the budget collects.
+// Fixed amount budgets do not take a rate of the source balance, so it returns zero for them.
+func (budget Budget) CollectionRate() sdk.Dec {
+	if budget.Type != BudgetTypeRate || budget.Rate.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return budget.Rate
+}
+
+// DenomRate returns the rate of the source balance of the denom that the budget collects.
+// It returns zero if the denom is denied or not allowed, the denom rate if the budget has one
+// for the denom, or the default rate otherwise.
+func (budget Budget) DenomRate(denom string) sdk.Dec {
+	if budget.Type != BudgetTypeRate {
+		return sdk.ZeroDec()
+	}
+	for _, deniedDenom := range budget.DeniedDenoms {
+		if deniedDenom == denom {
+			return sdk.ZeroDec()
+		}
+	}
+	if len(budget.AllowedDenoms) > 0 {
+		allowed := false
+		for _, allowedDenom := range budget.AllowedDenoms {
+			if allowedDenom == denom {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return sdk.ZeroDec()
+		}
+	}
+	for _, denomRate := range budget.DenomRates {
+		if denomRate.Denom == denom {
+			return denomRate.Rate
+		}
+	}
+	return budget.CollectionRate()
 }
 
-// CollectibleBudgets returns only the valid and started and not expired budgets based on the given block time.
-func CollectibleBudgets(budgets []Budget, blockTime time.Time) (collectibleBudgets []Budget) {
+// Denoms returns all denoms that are explicitly specified by the denom rates and denom lists of the budget.
+func (budget Budget) Denoms() []string {
+	var denoms []string
+	denoms = append(denoms, budget.AllowedDenoms...)
+	denoms = append(denoms, budget.DeniedDenoms...)
+	for _, denomRate := range budget.DenomRates {
+		denoms = append(denoms, denomRate.Denom)
+	}
+	return denoms
+}
+
+// HasTimeRange returns true if the budget has a start time or an end time.
+func (budget Budget) HasTimeRange() bool {
+	return !budget.StartTime.IsZero() || !budget.EndTime.IsZero()
+}
+
+// TimeRange returns the time range of the budget. The time range of a budget defined by
+// block heights only is unbounded.
+func (budget Budget) TimeRange() (startTime, endTime time.Time) {
+	if !budget.HasTimeRange() {
+		return time.Time{}, maxTime
+	}
+	return budget.StartTime, budget.EndTime
+}
+
+// HeightRangeOverlaps returns true if the height ranges of the budgets overlap each other.
+// An unset start or end height is unbounded.
+func (budget Budget) HeightRangeOverlaps(other Budget) bool {
+	return (budget.EndHeight == 0 || other.StartHeight < budget.EndHeight) &&
+		(other.EndHeight == 0 || budget.StartHeight < other.EndHeight)
+}
+
+// Collectible validates the budget is not paused, has reached its start time and start height and that
+// the end time and end height have not elapsed. Unset times and heights are not checked.
+func (budget Budget) Collectible(blockTime time.Time, height int64) bool {
+	if budget.Paused {
+		return false
+	}
+	if budget.HasTimeRange() && (budget.StartTime.After(blockTime) || !budget.EndTime.After(blockTime)) {
+		return false
+	}
+	return budget.StartHeight <= height && (budget.EndHeight == 0 || height < budget.EndHeight)
+}
+
+// Status returns the lifecycle status of the budget at the given block time and height, which is
+// upcoming before its start time or start height, expired from its end time or end height, and active
+// otherwise. The status does not depend on whether the budget is paused.
+func (budget Budget) Status(blockTime time.Time, height int64) BudgetStatus {
+	if budget.HasTimeRange() && !budget.EndTime.After(blockTime) || budget.EndHeight != 0 && height >= budget.EndHeight {
+		return BudgetStatusExpired
+	}
+	if budget.HasTimeRange() && budget.StartTime.After(blockTime) || height < budget.StartHeight {
+		return BudgetStatusUpcoming
+	}
+	return BudgetStatusActive
+}
+
+// CollectibleBudgets returns only the valid and started and not expired budgets that are not paused based on
+// the given block time and height.
+func CollectibleBudgets(budgets []Budget, blockTime time.Time, height int64) (collectibleBudgets []Budget) {
 	for _, budget := range budgets {
-		if budget.Collectible(blockTime) {
+		if budget.Collectible(blockTime, height) {
 			collectibleBudgets = append(collectibleBudgets, budget)
 		}
 	}
@@ -84,30 +621,107 @@ func ValidateName(name string) error {
 }
 
 // BudgetsBySource defines the total rate of budget lists.
+// TotalRate is the sum of the default rates of the budgets, rates of specific denoms are
+// checked with TotalDenomRate.
 type BudgetsBySource struct {
-	Budgets         []Budget
-	CollectionCoins []sdk.Coins
-	TotalRate       sdk.Dec
+	Budgets   []Budget
+	TotalRate sdk.Dec
 }
 
 type BudgetsBySourceMap map[string]BudgetsBySource
 
 // GetBudgetsBySourceMap returns BudgetsBySourceMap that has a list of budgets and their total rate
 // which contain the same SourceAddress. It can be used to track of what budgets are available with SourceAddress
-// and validate their total rate.
+// and validate their total rate. The map is keyed by the bech32 address that the source address resolves to.
 func GetBudgetsBySourceMap(budgets []Budget) BudgetsBySourceMap {
 	budgetsMap := make(BudgetsBySourceMap)
 	for _, budget := range budgets {
-		if budgetsBySource, ok := budgetsMap[budget.SourceAddress]; ok {
-			budgetsBySource.TotalRate = budgetsBySource.TotalRate.Add(budget.Rate)
+		source := resolvedAddressKey(budget.SourceAddress)
+		if budgetsBySource, ok := budgetsMap[source]; ok {
+			budgetsBySource.TotalRate = budgetsBySource.TotalRate.Add(budget.CollectionRate())
 			budgetsBySource.Budgets = append(budgetsBySource.Budgets, budget)
-			budgetsMap[budget.SourceAddress] = budgetsBySource
+			budgetsMap[source] = budgetsBySource
 		} else {
-			budgetsMap[budget.SourceAddress] = BudgetsBySource{
+			budgetsMap[source] = BudgetsBySource{
 				Budgets:   []Budget{budget},
-				TotalRate: budget.Rate,
+				TotalRate: budget.CollectionRate(),
 			}
 		}
 	}
 	return budgetsMap
 }
+
+// Denoms returns all denoms that are explicitly specified by the budgets, sorted.
+// An empty denom is included to represent any other denom that is not specified.
+func (budgetsBySource BudgetsBySource) Denoms() []string {
+	denomSet := map[string]bool{"": true}
+	for _, budget := range budgetsBySource.Budgets {
+		for _, denom := range budget.Denoms() {
+			denomSet[denom] = true
+		}
+	}
+	denoms := make([]string, 0, len(denomSet))
+	for denom := range denomSet {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+	return denoms
+}
+
+// Sources returns the source addresses of the map in sorted order.
+func (budgetsBySourceMap BudgetsBySourceMap) Sources() []string {
+	sources := make([]string, 0, len(budgetsBySourceMap))
+	for source := range budgetsBySourceMap {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+	return sources
+}
+
+// SortBudgetsByPriority sorts the budgets in descending order of priority. Budgets with the same
+// priority keep their order.
+func SortBudgetsByPriority(budgets []Budget) {
+	sort.SliceStable(budgets, func(i, j int) bool {
+		return budgets[i].Priority > budgets[j].Priority
+	})
+}
+
+// windowTimes returns the sorted times that split the time range of the budget into the time windows
+// in which the total rate of the budgets is verified. The time range is split at the schedule points
+// of the budgets, and at the start and end times of the other budgets if the budget has a schedule.
+// The time range of a budget without any schedule involved is verified as a whole.
+func (budgetsBySource BudgetsBySource) windowTimes(budget Budget) []time.Time {
+	startTime, endTime := budget.TimeRange()
+	times := []time.Time{startTime, endTime}
+	for _, other := range budgetsBySource.Budgets {
+		if other.Schedule != nil {
+			for _, point := range other.Schedule.Points {
+				times = append(times, point.Time)
+			}
+		}
+		if budget.Schedule != nil {
+			otherStartTime, otherEndTime := other.TimeRange()
+			times = append(times, otherStartTime, otherEndTime)
+		}
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	var windowTimes []time.Time
+	for _, t := range times {
+		if t.Before(startTime) || t.After(endTime) {
+			continue
+		}
+		if len(windowTimes) == 0 || t.After(windowTimes[len(windowTimes)-1]) {
+			windowTimes = append(windowTimes, t)
+		}
+	}
+	return windowTimes
+}
+
+// TotalDenomRate returns the sum of the rates of the denom for the budgets.
+func TotalDenomRate(budgets []Budget, denom string) sdk.Dec {
+	totalRate := sdk.ZeroDec()
+	for _, budget := range budgets {
+		totalRate = totalRate.Add(budget.DenomRate(denom))
+	}
+	return totalRate
+}
@@ -11,6 +11,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -30,14 +31,289 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// EpochMode enumerates the available modes of the epochs of the budgets.
+type EpochMode int32
+
+const (
+	// EPOCH_MODE_BLOCKS defines epochs of epoch_blocks blocks.
+	EpochModeBlocks EpochMode = 0
+	// EPOCH_MODE_DURATION defines epochs of epoch_duration, which start on the first block at or after each boundary.
+	EpochModeDuration EpochMode = 1
+)
+
+var EpochMode_name = map[int32]string{
+	0: "EPOCH_MODE_BLOCKS",
+	1: "EPOCH_MODE_DURATION",
+}
+
+var EpochMode_value = map[string]int32{
+	"EPOCH_MODE_BLOCKS":   0,
+	"EPOCH_MODE_DURATION": 1,
+}
+
+func (x EpochMode) String() string {
+	return proto.EnumName(EpochMode_name, int32(x))
+}
+
+func (EpochMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9df5cca239dd8691, []int{0}
+}
+
+// ProcessingMode enumerates the available processing modes of the budgets for a source address.
+type ProcessingMode int32
+
+const (
+	// PROCESSING_MODE_SHARED_SNAPSHOT defines that the rate budgets are calculated from the same source balance.
+	ProcessingModeSharedSnapshot ProcessingMode = 0
+	// PROCESSING_MODE_SEQUENTIAL defines that the budgets take from the source balance in order of priority.
+	ProcessingModeSequential ProcessingMode = 1
+)
+
+var ProcessingMode_name = map[int32]string{
+	0: "PROCESSING_MODE_SHARED_SNAPSHOT",
+	1: "PROCESSING_MODE_SEQUENTIAL",
+}
+
+var ProcessingMode_value = map[string]int32{
+	"PROCESSING_MODE_SHARED_SNAPSHOT": 0,
+	"PROCESSING_MODE_SEQUENTIAL":      1,
+}
+
+func (x ProcessingMode) String() string {
+	return proto.EnumName(ProcessingMode_name, int32(x))
+}
+
+func (ProcessingMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9df5cca239dd8691, []int{1}
+}
+
+// DestinationType enumerates the available types of a budget destination.
+type DestinationType int32
+
+const (
+	// DESTINATION_TYPE_ACCOUNT defines a destination that receives the coins at its address.
+	DestinationTypeAccount DestinationType = 0
+	// DESTINATION_TYPE_COMMUNITY_POOL defines a destination that funds the community pool of the distribution module.
+	DestinationTypeCommunityPool DestinationType = 1
+	// DESTINATION_TYPE_BURN defines a destination that burns the coins.
+	DestinationTypeBurn DestinationType = 2
+	// DESTINATION_TYPE_STAKING defines a destination that delegates the coins to validators from the delegator account
+	// of the budget.
+	DestinationTypeStaking DestinationType = 3
+	// DESTINATION_TYPE_IBC_TRANSFER defines a destination that sends the coins to another chain over ICS-20.
+	DestinationTypeIBCTransfer DestinationType = 4
+)
+
+var DestinationType_name = map[int32]string{
+	0: "DESTINATION_TYPE_ACCOUNT",
+	1: "DESTINATION_TYPE_COMMUNITY_POOL",
+	2: "DESTINATION_TYPE_BURN",
+	3: "DESTINATION_TYPE_STAKING",
+	4: "DESTINATION_TYPE_IBC_TRANSFER",
+}
+
+var DestinationType_value = map[string]int32{
+	"DESTINATION_TYPE_ACCOUNT":        0,
+	"DESTINATION_TYPE_COMMUNITY_POOL": 1,
+	"DESTINATION_TYPE_BURN":           2,
+	"DESTINATION_TYPE_STAKING":        3,
+	"DESTINATION_TYPE_IBC_TRANSFER":   4,
+}
+
+func (x DestinationType) String() string {
+	return proto.EnumName(DestinationType_name, int32(x))
+}
+
+func (DestinationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9df5cca239dd8691, []int{2}
+}
+
+// IBCFailureAction enumerates the actions taken with the coins of an IBC transfer that failed or timed out.
+type IBCFailureAction int32
+
+const (
+	// IBC_FAILURE_ACTION_REFUND defines an action that refunds the coins to the source address of the budget.
+	IBCFailureActionRefund IBCFailureAction = 0
+	// IBC_FAILURE_ACTION_ESCROW defines an action that parks the coins in the IBC escrow account of the budget,
+	// so that they are sent again with the next collection of the budget.
+	IBCFailureActionEscrow IBCFailureAction = 1
+)
+
+var IBCFailureAction_name = map[int32]string{
+	0: "IBC_FAILURE_ACTION_REFUND",
+	1: "IBC_FAILURE_ACTION_ESCROW",
+}
+
+var IBCFailureAction_value = map[string]int32{
+	"IBC_FAILURE_ACTION_REFUND": 0,
+	"IBC_FAILURE_ACTION_ESCROW": 1,
+}
+
+func (x IBCFailureAction) String() string {
+	return proto.EnumName(IBCFailureAction_name, int32(x))
+}
+
+func (IBCFailureAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9df5cca239dd8691, []int{3}
+}
+
+// ScheduleType enumerates the available types of a rate schedule.
+type ScheduleType int32
+
+const (
+	// SCHEDULE_TYPE_STEPS defines a schedule whose factor is that of the latest point.
+	ScheduleTypeSteps ScheduleType = 0
+	// SCHEDULE_TYPE_LINEAR defines a schedule whose factor is linearly interpolated between the points.
+	ScheduleTypeLinear ScheduleType = 1
+	// SCHEDULE_TYPE_EXPONENTIAL_DECAY defines a schedule whose factor halves continuously every period.
+	ScheduleTypeExponentialDecay ScheduleType = 2
+	// SCHEDULE_TYPE_HALVING defines a schedule whose factor halves at the end of every period.
+	ScheduleTypeHalving ScheduleType = 3
+)
+
+var ScheduleType_name = map[int32]string{
+	0: "SCHEDULE_TYPE_STEPS",
+	1: "SCHEDULE_TYPE_LINEAR",
+	2: "SCHEDULE_TYPE_EXPONENTIAL_DECAY",
+	3: "SCHEDULE_TYPE_HALVING",
+}
+
+var ScheduleType_value = map[string]int32{
+	"SCHEDULE_TYPE_STEPS":             0,
+	"SCHEDULE_TYPE_LINEAR":            1,
+	"SCHEDULE_TYPE_EXPONENTIAL_DECAY": 2,
+	"SCHEDULE_TYPE_HALVING":           3,
+}
+
+func (x ScheduleType) String() string {
+	return proto.EnumName(ScheduleType_name, int32(x))
+}
+
+func (ScheduleType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9df5cca239dd8691, []int{4}
+}
+
+// BudgetType enumerates the available types of a budget.
+type BudgetType int32
+
+const (
+	// BUDGET_TYPE_RATE defines a budget that collects a rate of the source balance.
+	BudgetTypeRate BudgetType = 0
+	// BUDGET_TYPE_FIXED_AMOUNT defines a budget that collects a fixed amount of coins every epoch.
+	BudgetTypeFixedAmount BudgetType = 1
+)
+
+var BudgetType_name = map[int32]string{
+	0: "BUDGET_TYPE_RATE",
+	1: "BUDGET_TYPE_FIXED_AMOUNT",
+}
+
+var BudgetType_value = map[string]int32{
+	"BUDGET_TYPE_RATE":         0,
+	"BUDGET_TYPE_FIXED_AMOUNT": 1,
+}
+
+func (x BudgetType) String() string {
+	return proto.EnumName(BudgetType_name, int32(x))
+}
+
+func (BudgetType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9df5cca239dd8691, []int{5}
+}
+
+// RecurrenceType enumerates the available types of a recurrence.
+type RecurrenceType int32
+
+const (
+	// RECURRENCE_TYPE_DAILY defines periods of a day.
+	RecurrenceTypeDaily RecurrenceType = 0
+	// RECURRENCE_TYPE_WEEKLY defines periods of a week.
+	RecurrenceTypeWeekly RecurrenceType = 1
+	// RECURRENCE_TYPE_MONTHLY defines periods of a calendar month.
+	RecurrenceTypeMonthly RecurrenceType = 2
+	// RECURRENCE_TYPE_INTERVAL defines periods of the interval of the recurrence.
+	RecurrenceTypeInterval RecurrenceType = 3
+)
+
+var RecurrenceType_name = map[int32]string{
+	0: "RECURRENCE_TYPE_DAILY",
+	1: "RECURRENCE_TYPE_WEEKLY",
+	2: "RECURRENCE_TYPE_MONTHLY",
+	3: "RECURRENCE_TYPE_INTERVAL",
+}
+
+var RecurrenceType_value = map[string]int32{
+	"RECURRENCE_TYPE_DAILY":    0,
+	"RECURRENCE_TYPE_WEEKLY":   1,
+	"RECURRENCE_TYPE_MONTHLY":  2,
+	"RECURRENCE_TYPE_INTERVAL": 3,
+}
+
+func (x RecurrenceType) String() string {
+	return proto.EnumName(RecurrenceType_name, int32(x))
+}
+
+func (RecurrenceType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9df5cca239dd8691, []int{6}
+}
+
+// BudgetStatus enumerates the lifecycle statuses of a budget.
+type BudgetStatus int32
+
+const (
+	// BUDGET_STATUS_UNSPECIFIED defines the status of a budget whose status has not been updated yet.
+	BudgetStatusUnspecified BudgetStatus = 0
+	// BUDGET_STATUS_UPCOMING defines the status of a budget that has not reached its start time or start height.
+	BudgetStatusUpcoming BudgetStatus = 1
+	// BUDGET_STATUS_ACTIVE defines the status of a budget within its time and height ranges.
+	BudgetStatusActive BudgetStatus = 2
+	// BUDGET_STATUS_EXPIRED defines the status of a budget archived after its end time or end height.
+	BudgetStatusExpired BudgetStatus = 3
+	// BUDGET_STATUS_REMOVED defines the status of a budget archived after it was deleted by a budget proposal.
+	BudgetStatusRemoved BudgetStatus = 4
+)
+
+var BudgetStatus_name = map[int32]string{
+	0: "BUDGET_STATUS_UNSPECIFIED",
+	1: "BUDGET_STATUS_UPCOMING",
+	2: "BUDGET_STATUS_ACTIVE",
+	3: "BUDGET_STATUS_EXPIRED",
+	4: "BUDGET_STATUS_REMOVED",
+}
+
+var BudgetStatus_value = map[string]int32{
+	"BUDGET_STATUS_UNSPECIFIED": 0,
+	"BUDGET_STATUS_UPCOMING":    1,
+	"BUDGET_STATUS_ACTIVE":      2,
+	"BUDGET_STATUS_EXPIRED":     3,
+	"BUDGET_STATUS_REMOVED":     4,
+}
+
+func (x BudgetStatus) String() string {
+	return proto.EnumName(BudgetStatus_name, int32(x))
+}
+
+func (BudgetStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9df5cca239dd8691, []int{7}
+}
+
 // Params defines the parameters for the budget module.
 type Params struct {
 	// The universal epoch length in number of blocks
 	// A collection of budgets is executed with this epoch_blocks parameter
 	EpochBlocks uint32 `protobuf:"varint,1,opt,name=epoch_blocks,json=epochBlocks,proto3" json:"epoch_blocks,omitempty" yaml:"epoch_blocks"`
-	// Budgets parameter can be added, modified, and deleted through
-	// parameter change governance proposal
-	Budgets []Budget `protobuf:"bytes,2,rep,name=budgets,proto3" json:"budgets" yaml:"budgets"`
+	// source_processing_modes specifies the processing modes of the budgets for source addresses, the budgets of a
+	// source address without a processing mode share the same source balance
+	SourceProcessingModes []SourceProcessingMode `protobuf:"bytes,3,rep,name=source_processing_modes,json=sourceProcessingModes,proto3" json:"source_processing_modes,omitempty" yaml:"source_processing_modes"`
+	// source_reserves specifies the reserve floors of source addresses, which are never collected by the budgets
+	SourceReserves []SourceReserve `protobuf:"bytes,4,rep,name=source_reserves,json=sourceReserves,proto3" json:"source_reserves,omitempty" yaml:"source_reserves"`
+	// epoch_mode specifies whether the epochs of the budgets are defined by epoch_blocks or epoch_duration
+	EpochMode EpochMode `protobuf:"varint,5,opt,name=epoch_mode,json=epochMode,proto3,enum=cosmos.budget.v1beta1.EpochMode" json:"epoch_mode,omitempty" yaml:"epoch_mode"`
+	// epoch_duration specifies the universal epoch length in time for the duration epoch mode
+	EpochDuration time.Duration `protobuf:"bytes,6,opt,name=epoch_duration,json=epochDuration,proto3,stdduration" json:"epoch_duration" yaml:"epoch_duration"`
+	// history_retention_blocks specifies the number of blocks for which the collection records of the budgets are
+	// kept, no collection records are kept if zero
+	HistoryRetentionBlocks uint64 `protobuf:"varint,7,opt,name=history_retention_blocks,json=historyRetentionBlocks,proto3" json:"history_retention_blocks,omitempty" yaml:"history_retention_blocks"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -79,13 +355,123 @@ func (m *Params) GetEpochBlocks() uint32 {
 	return 0
 }
 
-func (m *Params) GetBudgets() []Budget {
+func (m *Params) GetSourceProcessingModes() []SourceProcessingMode {
+	if m != nil {
+		return m.SourceProcessingModes
+	}
+	return nil
+}
+
+func (m *Params) GetSourceReserves() []SourceReserve {
 	if m != nil {
-		return m.Budgets
+		return m.SourceReserves
 	}
 	return nil
 }
 
+func (m *Params) GetEpochMode() EpochMode {
+	if m != nil {
+		return m.EpochMode
+	}
+	return EpochModeBlocks
+}
+
+func (m *Params) GetEpochDuration() time.Duration {
+	if m != nil {
+		return m.EpochDuration
+	}
+	return 0
+}
+
+func (m *Params) GetHistoryRetentionBlocks() uint64 {
+	if m != nil {
+		return m.HistoryRetentionBlocks
+	}
+	return 0
+}
+
+// SourceReserve defines the reserve floor of a source address.
+type SourceReserve struct {
+	// source_address defines the bech32-encoded address of the source
+	SourceAddress string `protobuf:"bytes,1,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty" yaml:"source_address"`
+	// reserve specifies the balance of each denom that the source always keeps
+	Reserve github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=reserve,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reserve" yaml:"reserve"`
+}
+
+func (m *SourceReserve) Reset()         { *m = SourceReserve{} }
+func (m *SourceReserve) String() string { return proto.CompactTextString(m) }
+func (*SourceReserve) ProtoMessage()    {}
+func (*SourceReserve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df5cca239dd8691, []int{1}
+}
+func (m *SourceReserve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SourceReserve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SourceReserve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SourceReserve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceReserve.Merge(m, src)
+}
+func (m *SourceReserve) XXX_Size() int {
+	return m.Size()
+}
+func (m *SourceReserve) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceReserve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceReserve proto.InternalMessageInfo
+
+// SourceProcessingMode defines the processing mode of the budgets for a source address.
+type SourceProcessingMode struct {
+	// source_address defines the bech32-encoded address of the source
+	SourceAddress string `protobuf:"bytes,1,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty" yaml:"source_address"`
+	// mode specifies how the budgets of the source address are processed
+	Mode ProcessingMode `protobuf:"varint,2,opt,name=mode,proto3,enum=cosmos.budget.v1beta1.ProcessingMode" json:"mode,omitempty" yaml:"mode"`
+}
+
+func (m *SourceProcessingMode) Reset()         { *m = SourceProcessingMode{} }
+func (m *SourceProcessingMode) String() string { return proto.CompactTextString(m) }
+func (*SourceProcessingMode) ProtoMessage()    {}
+func (*SourceProcessingMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df5cca239dd8691, []int{2}
+}
+func (m *SourceProcessingMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SourceProcessingMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SourceProcessingMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SourceProcessingMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SourceProcessingMode.Merge(m, src)
+}
+func (m *SourceProcessingMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *SourceProcessingMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_SourceProcessingMode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SourceProcessingMode proto.InternalMessageInfo
+
 // Budget defines a budget object.
 type Budget struct {
 	// name defines the name of the budget
@@ -96,16 +482,75 @@ type Budget struct {
 	SourceAddress string `protobuf:"bytes,3,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty" yaml:"source_address"`
 	// destination_address defines the bech32-encoded address of the budget pool to distribute
 	DestinationAddress string `protobuf:"bytes,4,opt,name=destination_address,json=destinationAddress,proto3" json:"destination_address,omitempty" yaml:"destination_address"`
-	// start_time specifies the start time of the budget
+	// start_time specifies the start time of the budget, unset if the budget is defined by block heights only
 	StartTime time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
-	// end_time specifies the end time of the budget
+	// end_time specifies the end time of the budget, unset if the budget is defined by block heights only
 	EndTime time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	// type specifies whether the budget collects a rate of the source balance or a fixed amount
+	Type BudgetType `protobuf:"varint,7,opt,name=type,proto3,enum=cosmos.budget.v1beta1.BudgetType" json:"type,omitempty" yaml:"type"`
+	// amount specifies the fixed amount of coins to collect every epoch, used when type is BUDGET_TYPE_FIXED_AMOUNT
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount,omitempty" yaml:"amount"`
+	// denom_rates specifies the rates for specific denoms that override the default rate
+	DenomRates []DenomRate `protobuf:"bytes,9,rep,name=denom_rates,json=denomRates,proto3" json:"denom_rates,omitempty" yaml:"denom_rates"`
+	// allowed_denoms specifies the denoms the budget collects, all denoms are collected if empty
+	AllowedDenoms []string `protobuf:"bytes,10,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty" yaml:"allowed_denoms"`
+	// denied_denoms specifies the denoms the budget never collects
+	DeniedDenoms []string `protobuf:"bytes,11,rep,name=denied_denoms,json=deniedDenoms,proto3" json:"denied_denoms,omitempty" yaml:"denied_denoms"`
+	// lifetime_cap specifies the maximum total collected coins of the budget, the budget stops collecting once
+	// the total collected coins reach it
+	LifetimeCap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,12,rep,name=lifetime_cap,json=lifetimeCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"lifetime_cap,omitempty" yaml:"lifetime_cap"`
+	// max_epoch_amount specifies the maximum amount of coins the budget collects in an epoch
+	MaxEpochAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=max_epoch_amount,json=maxEpochAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_epoch_amount,omitempty" yaml:"max_epoch_amount"`
+	// min_epoch_amount specifies the minimum amount of coins the budget collects in an epoch if the source has enough
+	// balances
+	MinEpochAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,14,rep,name=min_epoch_amount,json=minEpochAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_epoch_amount,omitempty" yaml:"min_epoch_amount"`
+	// destinations specifies the weighted destinations that split the collected coins, used instead of
+	// destination_address
+	Destinations []BudgetDestination `protobuf:"bytes,15,rep,name=destinations,proto3" json:"destinations,omitempty" yaml:"destinations"`
+	// schedule specifies the schedule that scales the rates and the amount of the budget over time, the rates and the
+	// amount are constant if it is not set
+	Schedule *RateSchedule `protobuf:"bytes,16,opt,name=schedule,proto3" json:"schedule,omitempty" yaml:"schedule"`
+	// start_height specifies the block height from which the budget is collectible, inclusive, unset if zero
+	StartHeight int64 `protobuf:"varint,17,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	// end_height specifies the block height from which the budget is not collectible anymore, exclusive, unset if
+	// zero
+	EndHeight int64 `protobuf:"varint,18,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty" yaml:"end_height"`
+	// recurrence specifies the calendar periods anchored to the start time in which the budget collects once, the
+	// budget collects every epoch if it is not set
+	Recurrence *Recurrence `protobuf:"bytes,19,opt,name=recurrence,proto3" json:"recurrence,omitempty" yaml:"recurrence"`
+	// priority specifies the precedence of the budget among the budgets of the same source address, a budget with
+	// a higher priority is processed first
+	Priority int32 `protobuf:"varint,20,opt,name=priority,proto3" json:"priority,omitempty" yaml:"priority"`
+	// reserve specifies the balance of each denom of the source that the budget never collects
+	Reserve github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,21,rep,name=reserve,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reserve,omitempty" yaml:"reserve"`
+	// paused specifies whether the budget is paused, a paused budget is kept with its state but does not collect
+	Paused bool `protobuf:"varint,22,opt,name=paused,proto3" json:"paused,omitempty" yaml:"paused"`
+	// conditions specifies the on-chain conditions that must hold for the budget to collect
+	Conditions *BudgetConditions `protobuf:"bytes,23,opt,name=conditions,proto3" json:"conditions,omitempty" yaml:"conditions"`
+	// epoch_blocks specifies the epoch length of the budget in number of blocks, the epoch_blocks parameter is used
+	// if zero
+	EpochBlocks uint32 `protobuf:"varint,24,opt,name=epoch_blocks,json=epochBlocks,proto3" json:"epoch_blocks,omitempty" yaml:"epoch_blocks"`
+	// epoch_offset specifies the phase of the epochs of the budget, the budget collects at the heights whose
+	// remainder divided by the epoch length is the offset
+	EpochOffset uint32 `protobuf:"varint,25,opt,name=epoch_offset,json=epochOffset,proto3" json:"epoch_offset,omitempty" yaml:"epoch_offset"`
+	// description specifies the purpose of the budget
+	Description string `protobuf:"bytes,26,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	// link specifies the URL of the proposal or the forum post of the budget
+	Link string `protobuf:"bytes,27,opt,name=link,proto3" json:"link,omitempty" yaml:"link"`
+	// link_hash specifies the hex-encoded SHA-256 hash of the content of the link
+	LinkHash string `protobuf:"bytes,28,opt,name=link_hash,json=linkHash,proto3" json:"link_hash,omitempty" yaml:"link_hash"`
+	// owner specifies the owner of the budget or the contact for it
+	Owner string `protobuf:"bytes,29,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// tags specifies the category tags of the budget
+	Tags []string `protobuf:"bytes,30,rep,name=tags,proto3" json:"tags,omitempty" yaml:"tags"`
+	// id specifies the id of the budget, which is assigned when the budget is added and never reused
+	ID uint64 `protobuf:"varint,31,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
 }
 
 func (m *Budget) Reset()      { *m = Budget{} }
 func (*Budget) ProtoMessage() {}
 func (*Budget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df5cca239dd8691, []int{1}
+	return fileDescriptor_9df5cca239dd8691, []int{3}
 }
 func (m *Budget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -134,24 +579,31 @@ func (m *Budget) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Budget proto.InternalMessageInfo
 
-// TotalCollectedCoins defines total collected coins with relevant metadata.
-type TotalCollectedCoins struct {
-	// total_collected_coins specifies the total collected coins in a budget ever since the budget is created
-	TotalCollectedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total_collected_coins,json=totalCollectedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_collected_coins" yaml:"total_collected_coins"`
+// BudgetConditions defines the on-chain conditions that must all hold for a budget to collect.
+// Unset conditions are not checked.
+type BudgetConditions struct {
+	// min_source_balance specifies the balance of each denom that the source must have at least
+	MinSourceBalance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=min_source_balance,json=minSourceBalance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_source_balance,omitempty" yaml:"min_source_balance"`
+	// max_destination_balance specifies the balance of each denom that each destination must have at most
+	MaxDestinationBalance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=max_destination_balance,json=maxDestinationBalance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_destination_balance,omitempty" yaml:"max_destination_balance"`
+	// min_bonded_ratio specifies the bonded ratio of the staking module that must be reached, unset if empty
+	MinBondedRatio *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min_bonded_ratio,json=minBondedRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_bonded_ratio,omitempty" yaml:"min_bonded_ratio"`
+	// max_bonded_ratio specifies the bonded ratio of the staking module that must not be exceeded, unset if empty
+	MaxBondedRatio *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_bonded_ratio,json=maxBondedRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_bonded_ratio,omitempty" yaml:"max_bonded_ratio"`
 }
 
-func (m *TotalCollectedCoins) Reset()         { *m = TotalCollectedCoins{} }
-func (m *TotalCollectedCoins) String() string { return proto.CompactTextString(m) }
-func (*TotalCollectedCoins) ProtoMessage()    {}
-func (*TotalCollectedCoins) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df5cca239dd8691, []int{2}
+func (m *BudgetConditions) Reset()         { *m = BudgetConditions{} }
+func (m *BudgetConditions) String() string { return proto.CompactTextString(m) }
+func (*BudgetConditions) ProtoMessage()    {}
+func (*BudgetConditions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df5cca239dd8691, []int{4}
 }
-func (m *TotalCollectedCoins) XXX_Unmarshal(b []byte) error {
+func (m *BudgetConditions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TotalCollectedCoins) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BudgetConditions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TotalCollectedCoins.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BudgetConditions.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -161,329 +613,5300 @@ func (m *TotalCollectedCoins) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *TotalCollectedCoins) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TotalCollectedCoins.Merge(m, src)
+func (m *BudgetConditions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BudgetConditions.Merge(m, src)
 }
-func (m *TotalCollectedCoins) XXX_Size() int {
+func (m *BudgetConditions) XXX_Size() int {
 	return m.Size()
 }
-func (m *TotalCollectedCoins) XXX_DiscardUnknown() {
-	xxx_messageInfo_TotalCollectedCoins.DiscardUnknown(m)
+func (m *BudgetConditions) XXX_DiscardUnknown() {
+	xxx_messageInfo_BudgetConditions.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TotalCollectedCoins proto.InternalMessageInfo
+var xxx_messageInfo_BudgetConditions proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*Params)(nil), "cosmos.budget.v1beta1.Params")
-	proto.RegisterType((*Budget)(nil), "cosmos.budget.v1beta1.Budget")
-	proto.RegisterType((*TotalCollectedCoins)(nil), "cosmos.budget.v1beta1.TotalCollectedCoins")
+// Recurrence defines the calendar periods in which a budget collects once, on the first block of each period.
+type Recurrence struct {
+	// type specifies the type of the recurrence
+	Type RecurrenceType `protobuf:"varint,1,opt,name=type,proto3,enum=cosmos.budget.v1beta1.RecurrenceType" json:"type,omitempty" yaml:"type"`
+	// interval specifies the length of the periods for the interval recurrence
+	Interval time.Duration `protobuf:"bytes,2,opt,name=interval,proto3,stdduration" json:"interval" yaml:"interval"`
 }
 
-func init() {
-	proto.RegisterFile("tendermint/budget/v1beta1/budget.proto", fileDescriptor_9df5cca239dd8691)
+func (m *Recurrence) Reset()         { *m = Recurrence{} }
+func (m *Recurrence) String() string { return proto.CompactTextString(m) }
+func (*Recurrence) ProtoMessage()    {}
+func (*Recurrence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df5cca239dd8691, []int{5}
 }
-
-var fileDescriptor_9df5cca239dd8691 = []byte{
-	// 586 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xed, 0x26, 0xa4, 0xed, 0x86, 0xb6, 0xc2, 0x21, 0x90, 0x04, 0xea, 0x8d, 0x8c, 0x54,
-	0x45, 0x42, 0xd8, 0x6a, 0xb9, 0x45, 0x42, 0x02, 0x17, 0xce, 0x2d, 0x56, 0x0f, 0x88, 0x8b, 0xb5,
-	0xb6, 0x17, 0xd7, 0xaa, 0xed, 0x8d, 0xbc, 0x1b, 0x44, 0xdf, 0x80, 0x63, 0x8f, 0x48, 0x1c, 0xe8,
-	0x99, 0x77, 0xe0, 0x5e, 0x89, 0x4b, 0x8f, 0x88, 0x83, 0x8b, 0x92, 0x37, 0xc8, 0x13, 0xa0, 0xfd,
-	0xe3, 0x10, 0xd4, 0x48, 0xed, 0x29, 0x9e, 0x99, 0x6f, 0x7e, 0x3b, 0x3b, 0xfb, 0x29, 0x60, 0x87,
-	0xe1, 0x3c, 0xc2, 0x45, 0x96, 0xe4, 0xcc, 0x09, 0xc6, 0x51, 0x8c, 0x99, 0xf3, 0x71, 0x37, 0xc0,
-	0x0c, 0xed, 0xaa, 0xd0, 0x1e, 0x15, 0x84, 0x11, 0xa3, 0x1d, 0x12, 0x9a, 0x11, 0x6a, 0xab, 0xa4,
-	0xd2, 0xf4, 0xee, 0xc7, 0x24, 0x26, 0x42, 0xe1, 0xf0, 0x2f, 0x29, 0xee, 0x75, 0xa5, 0xd8, 0x97,
-	0x05, 0xd5, 0x29, 0x4b, 0xa6, 0x8c, 0x9c, 0x00, 0x51, 0x3c, 0x3f, 0x29, 0x24, 0x49, 0xae, 0xea,
-	0x30, 0x26, 0x24, 0x4e, 0xb1, 0x23, 0xa2, 0x60, 0xfc, 0xc1, 0x61, 0x49, 0x86, 0x29, 0x43, 0xd9,
-	0xe8, 0x76, 0x00, 0xeb, 0xab, 0x0e, 0x1a, 0x87, 0xa8, 0x40, 0x19, 0x35, 0x86, 0xe0, 0x2e, 0x1e,
-	0x91, 0xf0, 0xd8, 0x0f, 0x52, 0x12, 0x9e, 0xd0, 0x8e, 0xde, 0xd7, 0x07, 0x1b, 0xee, 0xc3, 0x59,
-	0x09, 0x5b, 0xa7, 0x28, 0x4b, 0x87, 0xd6, 0x62, 0xd5, 0xf2, 0x9a, 0x22, 0x74, 0x45, 0x64, 0x1c,
-	0x80, 0x55, 0x79, 0x55, 0xda, 0x59, 0xe9, 0xd7, 0x06, 0xcd, 0xbd, 0x6d, 0x7b, 0xe9, 0x06, 0x6c,
-	0x57, 0x84, 0xee, 0x83, 0x8b, 0x12, 0x6a, 0xb3, 0x12, 0x6e, 0x4a, 0xb2, 0xea, 0xb5, 0xbc, 0x8a,
-	0x32, 0xac, 0x7f, 0x39, 0x87, 0x9a, 0xf5, 0xb3, 0x06, 0x1a, 0xb2, 0xc3, 0x78, 0x02, 0xea, 0x39,
-	0xca, 0xb0, 0x98, 0x6a, 0xdd, 0xdd, 0x9a, 0x95, 0xb0, 0x29, 0x7b, 0x79, 0xd6, 0xf2, 0x44, 0xd1,
-	0x78, 0x0b, 0xea, 0x05, 0x62, 0xb8, 0xb3, 0x22, 0x44, 0x2f, 0xf8, 0x21, 0xbf, 0x4b, 0xb8, 0x13,
-	0x27, 0xec, 0x78, 0x1c, 0xd8, 0x21, 0xc9, 0xd4, 0x76, 0xd5, 0xcf, 0x33, 0x1a, 0x9d, 0x38, 0xec,
-	0x74, 0x84, 0xa9, 0xfd, 0x1a, 0x87, 0xff, 0x90, 0x9c, 0x61, 0x79, 0x02, 0x65, 0xbc, 0x04, 0x9b,
-	0x94, 0x8c, 0x8b, 0x10, 0xfb, 0x28, 0x8a, 0x0a, 0x4c, 0x69, 0xa7, 0x26, 0xe0, 0xdd, 0x59, 0x09,
-	0xdb, 0x52, 0xfe, 0x7f, 0xdd, 0xf2, 0x36, 0x64, 0xe2, 0x95, 0x8c, 0x8d, 0x03, 0xd0, 0x8a, 0x30,
-	0x65, 0x49, 0x8e, 0x58, 0x42, 0xf2, 0x39, 0xa6, 0x2e, 0x30, 0xe6, 0xac, 0x84, 0x3d, 0x89, 0x59,
-	0x22, 0xb2, 0x3c, 0x63, 0x21, 0x5b, 0x01, 0xdf, 0x01, 0x40, 0x19, 0x2a, 0x98, 0xcf, 0x1f, 0xbb,
-	0x73, 0xa7, 0xaf, 0x0f, 0x9a, 0x7b, 0x3d, 0x5b, 0x3a, 0xc1, 0xae, 0x9c, 0x60, 0x1f, 0x55, 0x4e,
-	0x70, 0xb7, 0xd5, 0xb2, 0xef, 0xa9, 0x71, 0xe7, 0xbd, 0xd6, 0xd9, 0x15, 0xd4, 0xbd, 0x75, 0x91,
-	0xe0, 0x72, 0xc3, 0x03, 0x6b, 0x38, 0x8f, 0x24, 0xb7, 0x71, 0x23, 0xf7, 0x91, 0xe2, 0x6e, 0x29,
-	0x7b, 0xa8, 0x4e, 0x49, 0x5d, 0xc5, 0x79, 0xc4, 0xa5, 0xc3, 0xb5, 0xcf, 0xe7, 0x50, 0x13, 0xaf,
-	0xf9, 0x43, 0x07, 0xad, 0x23, 0xc2, 0x50, 0xba, 0x4f, 0xd2, 0x14, 0x87, 0x0c, 0x47, 0xfb, 0x24,
-	0xc9, 0xa9, 0xf1, 0x4d, 0x07, 0x6d, 0xc6, 0xf3, 0x7e, 0x58, 0x15, 0x7c, 0x6e, 0x51, 0x6e, 0x41,
-	0xee, 0xa5, 0xee, 0xdc, 0x4b, 0x88, 0xe2, 0xb9, 0x93, 0x78, 0xaf, 0x7b, 0xa8, 0x46, 0x78, 0x2c,
-	0x47, 0x58, 0x4a, 0xb1, 0xbe, 0x5f, 0xc1, 0xc1, 0x2d, 0x2c, 0x20, 0x86, 0xf1, 0x5a, 0xec, 0xfa,
-	0x84, 0xc3, 0x3a, 0xbf, 0x83, 0xfb, 0xe6, 0x62, 0x62, 0xea, 0x97, 0x13, 0x53, 0xff, 0x33, 0x31,
-	0xf5, 0xb3, 0xa9, 0xa9, 0x5d, 0x4e, 0x4d, 0xed, 0xd7, 0xd4, 0xd4, 0xde, 0x3f, 0x5d, 0xc0, 0x5f,
-	0xff, 0x87, 0xf8, 0x54, 0x7d, 0x88, 0x73, 0x82, 0x86, 0x58, 0xe5, 0xf3, 0xbf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xe6, 0xce, 0xde, 0xc1, 0x4c, 0x04, 0x00, 0x00,
+func (m *Recurrence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Recurrence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Recurrence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *Recurrence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Recurrence.Merge(m, src)
+}
+func (m *Recurrence) XXX_Size() int {
+	return m.Size()
+}
+func (m *Recurrence) XXX_DiscardUnknown() {
+	xxx_messageInfo_Recurrence.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_Recurrence proto.InternalMessageInfo
+
+// RateSchedule defines a schedule of the factor that scales the rates and the amount of a budget over time.
+type RateSchedule struct {
+	// type specifies the type of the schedule
+	Type ScheduleType `protobuf:"varint,1,opt,name=type,proto3,enum=cosmos.budget.v1beta1.ScheduleType" json:"type,omitempty" yaml:"type"`
+	// points specifies the factors at points in time for the steps and linear schedules
+	Points []SchedulePoint `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty" yaml:"points"`
+	// period specifies the half-life of the exponential decay schedule and the halving period of the halving schedule,
+	// measured from the start time of the budget
+	Period time.Duration `protobuf:"bytes,3,opt,name=period,proto3,stdduration" json:"period" yaml:"period"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Budgets) > 0 {
-		for iNdEx := len(m.Budgets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Budgets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBudget(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *RateSchedule) Reset()         { *m = RateSchedule{} }
+func (m *RateSchedule) String() string { return proto.CompactTextString(m) }
+func (*RateSchedule) ProtoMessage()    {}
+func (*RateSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df5cca239dd8691, []int{6}
+}
+func (m *RateSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.EpochBlocks != 0 {
-		i = encodeVarintBudget(dAtA, i, uint64(m.EpochBlocks))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *Budget) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *RateSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateSchedule.Merge(m, src)
+}
+func (m *RateSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateSchedule.DiscardUnknown(m)
 }
 
-func (m *Budget) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_RateSchedule proto.InternalMessageInfo
+
+// SchedulePoint defines the factor of a schedule at a point in time.
+type SchedulePoint struct {
+	// time specifies the point in time
+	Time time.Time `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	// factor specifies the factor at the point in time, between 0 and 1
+	Factor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=factor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"factor" yaml:"factor"`
 }
 
-func (m *Budget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintBudget(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x32
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintBudget(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x2a
-	if len(m.DestinationAddress) > 0 {
-		i -= len(m.DestinationAddress)
-		copy(dAtA[i:], m.DestinationAddress)
-		i = encodeVarintBudget(dAtA, i, uint64(len(m.DestinationAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.SourceAddress) > 0 {
-		i -= len(m.SourceAddress)
-		copy(dAtA[i:], m.SourceAddress)
-		i = encodeVarintBudget(dAtA, i, uint64(len(m.SourceAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	{
-		size := m.Rate.Size()
-		i -= size
-		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *SchedulePoint) Reset()         { *m = SchedulePoint{} }
+func (m *SchedulePoint) String() string { return proto.CompactTextString(m) }
+func (*SchedulePoint) ProtoMessage()    {}
+func (*SchedulePoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df5cca239dd8691, []int{7}
+}
+func (m *SchedulePoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchedulePoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchedulePoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintBudget(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintBudget(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SchedulePoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchedulePoint.Merge(m, src)
+}
+func (m *SchedulePoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchedulePoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchedulePoint.DiscardUnknown(m)
 }
 
-func (m *TotalCollectedCoins) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SchedulePoint proto.InternalMessageInfo
+
+// BudgetDestination defines a destination of a budget with its relative weight.
+type BudgetDestination struct {
+	// address defines the bech32-encoded address of the destination, empty for the destinations that are not accounts
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// weight specifies the relative weight of the destination among the destinations of the budget
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight" yaml:"weight"`
+	// type specifies the type of the destination
+	Type DestinationType `protobuf:"varint,3,opt,name=type,proto3,enum=cosmos.budget.v1beta1.DestinationType" json:"type,omitempty" yaml:"type"`
+	// validators specifies the bech32-encoded operator addresses of the validators that a staking destination
+	// delegates to
+	Validators []string `protobuf:"bytes,4,rep,name=validators,proto3" json:"validators,omitempty" yaml:"validators"`
+	// rewards_address specifies the bech32-encoded address or address reference that the delegation rewards of
+	// a staking destination are forwarded to, the rewards are delegated again if empty
+	RewardsAddress string `protobuf:"bytes,5,opt,name=rewards_address,json=rewardsAddress,proto3" json:"rewards_address,omitempty" yaml:"rewards_address"`
+	// ibc_transfer specifies the ICS-20 transfer of an IBC transfer destination
+	IBCTransfer *IBCTransfer `protobuf:"bytes,6,opt,name=ibc_transfer,json=ibcTransfer,proto3" json:"ibc_transfer,omitempty" yaml:"ibc_transfer"`
+}
+
+func (m *BudgetDestination) Reset()         { *m = BudgetDestination{} }
+func (m *BudgetDestination) String() string { return proto.CompactTextString(m) }
+func (*BudgetDestination) ProtoMessage()    {}
+func (*BudgetDestination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df5cca239dd8691, []int{8}
+}
+func (m *BudgetDestination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BudgetDestination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BudgetDestination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *BudgetDestination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BudgetDestination.Merge(m, src)
+}
+func (m *BudgetDestination) XXX_Size() int {
+	return m.Size()
+}
+func (m *BudgetDestination) XXX_DiscardUnknown() {
+	xxx_messageInfo_BudgetDestination.DiscardUnknown(m)
 }
 
-func (m *TotalCollectedCoins) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_BudgetDestination proto.InternalMessageInfo
+
+// IBCTransfer defines the ICS-20 transfer of the coins collected by an IBC transfer destination.
+type IBCTransfer struct {
+	// source_port specifies the port of the transfer channel
+	SourcePort string `protobuf:"bytes,1,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty" yaml:"source_port"`
+	// source_channel specifies the channel to send the coins through
+	SourceChannel string `protobuf:"bytes,2,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty" yaml:"source_channel"`
+	// receiver specifies the address of the receiver on the counterparty chain
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty" yaml:"receiver"`
+	// timeout specifies the duration after the block time of the collection at which the transfer times out
+	Timeout time.Duration `protobuf:"bytes,4,opt,name=timeout,proto3,stdduration" json:"timeout" yaml:"timeout"`
+	// on_failure specifies the action taken with the coins if the transfer fails or times out
+	OnFailure IBCFailureAction `protobuf:"varint,5,opt,name=on_failure,json=onFailure,proto3,enum=cosmos.budget.v1beta1.IBCFailureAction" json:"on_failure,omitempty" yaml:"on_failure"`
 }
 
-func (m *TotalCollectedCoins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalCollectedCoins) > 0 {
-		for iNdEx := len(m.TotalCollectedCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalCollectedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBudget(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *IBCTransfer) Reset()         { *m = IBCTransfer{} }
+func (m *IBCTransfer) String() string { return proto.CompactTextString(m) }
+func (*IBCTransfer) ProtoMessage()    {}
+func (*IBCTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df5cca239dd8691, []int{9}
+}
+func (m *IBCTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IBCTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IBCTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *IBCTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IBCTransfer.Merge(m, src)
+}
+func (m *IBCTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *IBCTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_IBCTransfer.DiscardUnknown(m)
 }
 
-func encodeVarintBudget(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBudget(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+var xxx_messageInfo_IBCTransfer proto.InternalMessageInfo
+
+// DenomRate defines a rate of the source balance for a specific denom.
+type DenomRate struct {
+	// denom specifies the denom that the rate is applied to
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// rate specifies the distributing amount by ratio of the source balance of the denom
+	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate" yaml:"rate"`
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EpochBlocks != 0 {
-		n += 1 + sovBudget(uint64(m.EpochBlocks))
-	}
-	if len(m.Budgets) > 0 {
-		for _, e := range m.Budgets {
-			l = e.Size()
-			n += 1 + l + sovBudget(uint64(l))
+
+func (m *DenomRate) Reset()         { *m = DenomRate{} }
+func (m *DenomRate) String() string { return proto.CompactTextString(m) }
+func (*DenomRate) ProtoMessage()    {}
+func (*DenomRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df5cca239dd8691, []int{10}
+}
+func (m *DenomRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *DenomRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomRate.Merge(m, src)
+}
+func (m *DenomRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomRate.DiscardUnknown(m)
 }
 
-func (m *Budget) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovBudget(uint64(l))
-	}
-	l = m.Rate.Size()
-	n += 1 + l + sovBudget(uint64(l))
-	l = len(m.SourceAddress)
-	if l > 0 {
-		n += 1 + l + sovBudget(uint64(l))
-	}
-	l = len(m.DestinationAddress)
-	if l > 0 {
-		n += 1 + l + sovBudget(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovBudget(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovBudget(uint64(l))
-	return n
+var xxx_messageInfo_DenomRate proto.InternalMessageInfo
+
+// TotalCollectedCoins defines total collected coins with relevant metadata.
+type TotalCollectedCoins struct {
+	// total_collected_coins specifies the total collected coins in a budget ever since the budget is created
+	TotalCollectedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total_collected_coins,json=totalCollectedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_collected_coins" yaml:"total_collected_coins"`
 }
 
-func (m *TotalCollectedCoins) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TotalCollectedCoins) Reset()         { *m = TotalCollectedCoins{} }
+func (m *TotalCollectedCoins) String() string { return proto.CompactTextString(m) }
+func (*TotalCollectedCoins) ProtoMessage()    {}
+func (*TotalCollectedCoins) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df5cca239dd8691, []int{11}
+}
+func (m *TotalCollectedCoins) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TotalCollectedCoins) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TotalCollectedCoins.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.TotalCollectedCoins) > 0 {
-		for _, e := range m.TotalCollectedCoins {
-			l = e.Size()
-			n += 1 + l + sovBudget(uint64(l))
+}
+func (m *TotalCollectedCoins) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TotalCollectedCoins.Merge(m, src)
+}
+func (m *TotalCollectedCoins) XXX_Size() int {
+	return m.Size()
+}
+func (m *TotalCollectedCoins) XXX_DiscardUnknown() {
+	xxx_messageInfo_TotalCollectedCoins.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TotalCollectedCoins proto.InternalMessageInfo
+
+// TotalBurnedCoins defines the total coins burned by all budgets.
+type TotalBurnedCoins struct {
+	// total_burned_coins specifies the total coins burned by all budgets
+	TotalBurnedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total_burned_coins,json=totalBurnedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_burned_coins" yaml:"total_burned_coins"`
+}
+
+func (m *TotalBurnedCoins) Reset()         { *m = TotalBurnedCoins{} }
+func (m *TotalBurnedCoins) String() string { return proto.CompactTextString(m) }
+func (*TotalBurnedCoins) ProtoMessage()    {}
+func (*TotalBurnedCoins) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df5cca239dd8691, []int{12}
+}
+func (m *TotalBurnedCoins) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TotalBurnedCoins) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TotalBurnedCoins.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *TotalBurnedCoins) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TotalBurnedCoins.Merge(m, src)
+}
+func (m *TotalBurnedCoins) XXX_Size() int {
+	return m.Size()
+}
+func (m *TotalBurnedCoins) XXX_DiscardUnknown() {
+	xxx_messageInfo_TotalBurnedCoins.DiscardUnknown(m)
 }
 
-func sovBudget(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+var xxx_messageInfo_TotalBurnedCoins proto.InternalMessageInfo
+
+// Remainder defines the fractional remainder of the coins of a budget, which is carried forward to the next
+// collection of the budget.
+type Remainder struct {
+	// remainder specifies the fractional remainder of each denom
+	Remainder github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=remainder,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"remainder" yaml:"remainder"`
 }
-func sozBudget(x uint64) (n int) {
-	return sovBudget(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *Remainder) Reset()         { *m = Remainder{} }
+func (m *Remainder) String() string { return proto.CompactTextString(m) }
+func (*Remainder) ProtoMessage()    {}
+func (*Remainder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df5cca239dd8691, []int{13}
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
+func (m *Remainder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Remainder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Remainder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Remainder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Remainder.Merge(m, src)
+}
+func (m *Remainder) XXX_Size() int {
+	return m.Size()
+}
+func (m *Remainder) XXX_DiscardUnknown() {
+	xxx_messageInfo_Remainder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Remainder proto.InternalMessageInfo
+
+// ArchivedBudget defines a budget that has expired or has been removed, with its records.
+type ArchivedBudget struct {
+	// budget defines the budget as it was when it was archived
+	Budget Budget `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget"`
+	// status specifies whether the budget has expired or has been removed
+	Status BudgetStatus `protobuf:"varint,2,opt,name=status,proto3,enum=cosmos.budget.v1beta1.BudgetStatus" json:"status,omitempty" yaml:"status"`
+	// archived_height specifies the block height at which the budget was archived
+	ArchivedHeight int64 `protobuf:"varint,3,opt,name=archived_height,json=archivedHeight,proto3" json:"archived_height,omitempty" yaml:"archived_height"`
+	// archived_time specifies the block time at which the budget was archived
+	ArchivedTime time.Time `protobuf:"bytes,4,opt,name=archived_time,json=archivedTime,proto3,stdtime" json:"archived_time" yaml:"archived_time"`
+	// total_collected_coins specifies the total collected coins of the budget
+	TotalCollectedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_collected_coins,json=totalCollectedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_collected_coins" yaml:"total_collected_coins"`
+	// destination_collected_coins specifies the total collected coins for each destination of the budget
+	DestinationCollectedCoins []DestinationCollectedCoins `protobuf:"bytes,6,rep,name=destination_collected_coins,json=destinationCollectedCoins,proto3" json:"destination_collected_coins" yaml:"destination_collected_coins"`
+	// unbonding specifies whether the delegations of the staking destination of the budget are unbonding, and
+	// the coins of its delegator account are returned to its source once they are unbonded
+	Unbonding bool `protobuf:"varint,7,opt,name=unbonding,proto3" json:"unbonding,omitempty" yaml:"unbonding"`
+}
+
+func (m *ArchivedBudget) Reset()         { *m = ArchivedBudget{} }
+func (m *ArchivedBudget) String() string { return proto.CompactTextString(m) }
+func (*ArchivedBudget) ProtoMessage()    {}
+func (*ArchivedBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df5cca239dd8691, []int{14}
+}
+func (m *ArchivedBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArchivedBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArchivedBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArchivedBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchivedBudget.Merge(m, src)
+}
+func (m *ArchivedBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArchivedBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchivedBudget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchivedBudget proto.InternalMessageInfo
+
+// CollectionRecord defines the record of a collection of a budget.
+type CollectionRecord struct {
+	// budget_id defines the id of the budget
+	BudgetID uint64 `protobuf:"varint,1,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty" yaml:"budget_id"`
+	// height specifies the block height of the collection
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	// time specifies the block time of the collection
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	// source_balances specifies the balances of the source address before the collection
+	SourceBalances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=source_balances,json=sourceBalances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"source_balances" yaml:"source_balances"`
+	// collected_coins specifies the coins collected by the budget
+	CollectedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=collected_coins,json=collectedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"collected_coins" yaml:"collected_coins"`
+}
+
+func (m *CollectionRecord) Reset()         { *m = CollectionRecord{} }
+func (m *CollectionRecord) String() string { return proto.CompactTextString(m) }
+func (*CollectionRecord) ProtoMessage()    {}
+func (*CollectionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df5cca239dd8691, []int{15}
+}
+func (m *CollectionRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollectionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollectionRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CollectionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionRecord.Merge(m, src)
+}
+func (m *CollectionRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollectionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionRecord proto.InternalMessageInfo
+
+// DestinationCollectedCoins defines total collected coins of a destination of a budget.
+type DestinationCollectedCoins struct {
+	// destination_address defines the bech32-encoded address of the destination
+	DestinationAddress string `protobuf:"bytes,1,opt,name=destination_address,json=destinationAddress,proto3" json:"destination_address,omitempty" yaml:"destination_address"`
+	// total_collected_coins specifies the total collected coins for the destination ever since the budget is created
+	TotalCollectedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_collected_coins,json=totalCollectedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_collected_coins" yaml:"total_collected_coins"`
+}
+
+func (m *DestinationCollectedCoins) Reset()         { *m = DestinationCollectedCoins{} }
+func (m *DestinationCollectedCoins) String() string { return proto.CompactTextString(m) }
+func (*DestinationCollectedCoins) ProtoMessage()    {}
+func (*DestinationCollectedCoins) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df5cca239dd8691, []int{16}
+}
+func (m *DestinationCollectedCoins) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DestinationCollectedCoins) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DestinationCollectedCoins.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DestinationCollectedCoins) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DestinationCollectedCoins.Merge(m, src)
+}
+func (m *DestinationCollectedCoins) XXX_Size() int {
+	return m.Size()
+}
+func (m *DestinationCollectedCoins) XXX_DiscardUnknown() {
+	xxx_messageInfo_DestinationCollectedCoins.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DestinationCollectedCoins proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("cosmos.budget.v1beta1.EpochMode", EpochMode_name, EpochMode_value)
+	proto.RegisterEnum("cosmos.budget.v1beta1.ProcessingMode", ProcessingMode_name, ProcessingMode_value)
+	proto.RegisterEnum("cosmos.budget.v1beta1.DestinationType", DestinationType_name, DestinationType_value)
+	proto.RegisterEnum("cosmos.budget.v1beta1.IBCFailureAction", IBCFailureAction_name, IBCFailureAction_value)
+	proto.RegisterEnum("cosmos.budget.v1beta1.ScheduleType", ScheduleType_name, ScheduleType_value)
+	proto.RegisterEnum("cosmos.budget.v1beta1.BudgetType", BudgetType_name, BudgetType_value)
+	proto.RegisterEnum("cosmos.budget.v1beta1.RecurrenceType", RecurrenceType_name, RecurrenceType_value)
+	proto.RegisterEnum("cosmos.budget.v1beta1.BudgetStatus", BudgetStatus_name, BudgetStatus_value)
+	proto.RegisterType((*Params)(nil), "cosmos.budget.v1beta1.Params")
+	proto.RegisterType((*SourceReserve)(nil), "cosmos.budget.v1beta1.SourceReserve")
+	proto.RegisterType((*SourceProcessingMode)(nil), "cosmos.budget.v1beta1.SourceProcessingMode")
+	proto.RegisterType((*Budget)(nil), "cosmos.budget.v1beta1.Budget")
+	proto.RegisterType((*BudgetConditions)(nil), "cosmos.budget.v1beta1.BudgetConditions")
+	proto.RegisterType((*Recurrence)(nil), "cosmos.budget.v1beta1.Recurrence")
+	proto.RegisterType((*RateSchedule)(nil), "cosmos.budget.v1beta1.RateSchedule")
+	proto.RegisterType((*SchedulePoint)(nil), "cosmos.budget.v1beta1.SchedulePoint")
+	proto.RegisterType((*BudgetDestination)(nil), "cosmos.budget.v1beta1.BudgetDestination")
+	proto.RegisterType((*IBCTransfer)(nil), "cosmos.budget.v1beta1.IBCTransfer")
+	proto.RegisterType((*DenomRate)(nil), "cosmos.budget.v1beta1.DenomRate")
+	proto.RegisterType((*TotalCollectedCoins)(nil), "cosmos.budget.v1beta1.TotalCollectedCoins")
+	proto.RegisterType((*TotalBurnedCoins)(nil), "cosmos.budget.v1beta1.TotalBurnedCoins")
+	proto.RegisterType((*Remainder)(nil), "cosmos.budget.v1beta1.Remainder")
+	proto.RegisterType((*ArchivedBudget)(nil), "cosmos.budget.v1beta1.ArchivedBudget")
+	proto.RegisterType((*CollectionRecord)(nil), "cosmos.budget.v1beta1.CollectionRecord")
+	proto.RegisterType((*DestinationCollectedCoins)(nil), "cosmos.budget.v1beta1.DestinationCollectedCoins")
+}
+
+func init() {
+	proto.RegisterFile("tendermint/budget/v1beta1/budget.proto", fileDescriptor_9df5cca239dd8691)
+}
+
+var fileDescriptor_9df5cca239dd8691 = []byte{
+	// 3362 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x6f, 0xe3, 0xc6,
+	0xd9, 0x37, 0x65, 0xd9, 0x6b, 0x8d, 0xbf, 0xe4, 0xf1, 0x17, 0xad, 0xdd, 0x35, 0xb5, 0xdc, 0x37,
+	0x1b, 0x67, 0x93, 0xd8, 0xc9, 0xe6, 0xcd, 0x9b, 0x37, 0x9b, 0x77, 0xf1, 0x46, 0x94, 0xe8, 0xb5,
+	0xb2, 0xb6, 0xa4, 0x8c, 0xe4, 0xfd, 0x28, 0x90, 0xaa, 0x34, 0x39, 0xb6, 0x89, 0x95, 0x48, 0x95,
+	0xa4, 0xfc, 0x71, 0xee, 0x21, 0x81, 0x11, 0x04, 0xc9, 0xa1, 0x6d, 0x80, 0xd6, 0xe8, 0x02, 0xbd,
+	0xe5, 0x50, 0xb4, 0x05, 0xda, 0x02, 0x05, 0x0a, 0xf4, 0x54, 0xa4, 0x3d, 0xe5, 0x58, 0xf4, 0xa0,
+	0x14, 0x9b, 0x4b, 0xb1, 0x40, 0x0f, 0xf5, 0x5f, 0x50, 0xcc, 0x07, 0x45, 0x52, 0x96, 0xac, 0x75,
+	0x36, 0x05, 0x72, 0xb2, 0x38, 0xf3, 0xfc, 0x7e, 0xfc, 0xcd, 0xcc, 0x33, 0x33, 0xcf, 0xf3, 0xd0,
+	0xe0, 0x9a, 0x87, 0x2d, 0x03, 0x3b, 0x75, 0xd3, 0xf2, 0x56, 0xb6, 0x9a, 0xc6, 0x0e, 0xf6, 0x56,
+	0xf6, 0x5e, 0xdd, 0xc2, 0x9e, 0xf6, 0x2a, 0x7f, 0x5c, 0x6e, 0x38, 0xb6, 0x67, 0xc3, 0x59, 0xdd,
+	0x76, 0xeb, 0xb6, 0xbb, 0xcc, 0x1b, 0xb9, 0x4d, 0x6a, 0x66, 0xc7, 0xde, 0xb1, 0xa9, 0xc5, 0x0a,
+	0xf9, 0xc5, 0x8c, 0x53, 0x0b, 0xcc, 0xb8, 0xca, 0x3a, 0x38, 0x92, 0x75, 0x2d, 0xb2, 0xa7, 0x95,
+	0x2d, 0xcd, 0xc5, 0xed, 0x37, 0xe9, 0xb6, 0x69, 0xf1, 0x7e, 0x69, 0xc7, 0xb6, 0x77, 0x6a, 0x78,
+	0x85, 0x3e, 0x6d, 0x35, 0xb7, 0x57, 0x3c, 0xb3, 0x8e, 0x5d, 0x4f, 0xab, 0x37, 0x7c, 0x82, 0x4e,
+	0x03, 0xa3, 0xe9, 0x68, 0x9e, 0x69, 0x73, 0x02, 0xf9, 0x37, 0x43, 0x60, 0xb8, 0xa4, 0x39, 0x5a,
+	0xdd, 0x85, 0x37, 0xc1, 0x18, 0x6e, 0xd8, 0xfa, 0x6e, 0x75, 0xab, 0x66, 0xeb, 0x0f, 0x5d, 0x51,
+	0x48, 0x0b, 0x4b, 0xe3, 0xca, 0xfc, 0x49, 0x4b, 0x9a, 0x3e, 0xd4, 0xea, 0xb5, 0x9b, 0x72, 0xb8,
+	0x57, 0x46, 0xa3, 0xf4, 0x51, 0xa1, 0x4f, 0xf0, 0x97, 0x02, 0x98, 0x77, 0xed, 0xa6, 0xa3, 0x63,
+	0x32, 0x0a, 0x1d, 0xbb, 0xae, 0x69, 0xed, 0x54, 0xeb, 0xb6, 0x81, 0x5d, 0x71, 0x30, 0x3d, 0xb8,
+	0x34, 0x7a, 0xe3, 0xc5, 0xe5, 0xae, 0x53, 0xb2, 0x5c, 0xa6, 0xa8, 0x52, 0x1b, 0xb4, 0x61, 0x1b,
+	0x58, 0xb9, 0xf3, 0x79, 0x4b, 0x1a, 0x78, 0xd2, 0x92, 0xae, 0xf4, 0xe0, 0x7c, 0xc9, 0xae, 0x9b,
+	0x1e, 0xae, 0x37, 0xbc, 0xc3, 0x93, 0x96, 0xb4, 0xc8, 0xd4, 0xf5, 0x30, 0x95, 0xd1, 0xac, 0xdb,
+	0xe5, 0x15, 0x2e, 0x3c, 0x12, 0xc0, 0x24, 0xc7, 0x38, 0xd8, 0xc5, 0xce, 0x1e, 0x76, 0xc5, 0x38,
+	0x95, 0xfa, 0x5f, 0x67, 0x4a, 0x45, 0xcc, 0x58, 0x79, 0x8b, 0x6b, 0x5c, 0xe8, 0x20, 0x89, 0x68,
+	0x9b, 0x8b, 0x68, 0xf3, 0x4d, 0x64, 0x34, 0xe1, 0x86, 0xb9, 0x5c, 0x78, 0x17, 0x00, 0x36, 0xbb,
+	0x44, 0xb3, 0x38, 0x94, 0x16, 0x96, 0x26, 0x6e, 0xa4, 0x7b, 0xc8, 0x50, 0x89, 0x21, 0x9d, 0xa6,
+	0xd9, 0x93, 0x96, 0x34, 0x15, 0x5e, 0x1b, 0x82, 0x96, 0x51, 0x02, 0xfb, 0x16, 0x50, 0x07, 0x13,
+	0xac, 0xc7, 0x5f, 0x76, 0x71, 0x38, 0x2d, 0x2c, 0x8d, 0xde, 0x58, 0x58, 0x66, 0x7e, 0xb1, 0xec,
+	0xfb, 0xc5, 0x72, 0x8e, 0x1b, 0x28, 0x57, 0xc8, 0xb8, 0x4e, 0x5a, 0xd2, 0x6c, 0x98, 0xd8, 0x87,
+	0xcb, 0x9f, 0x7e, 0x29, 0x09, 0x68, 0x9c, 0x36, 0xfa, 0x08, 0xf8, 0x1e, 0x10, 0x77, 0x4d, 0xd7,
+	0xb3, 0x9d, 0xc3, 0xaa, 0x83, 0x3d, 0x6c, 0x91, 0x46, 0xdf, 0x89, 0x2e, 0xa4, 0x85, 0xa5, 0xb8,
+	0x72, 0xf5, 0xa4, 0x25, 0x49, 0x8c, 0xaf, 0x97, 0xa5, 0x8c, 0xe6, 0x78, 0x17, 0xf2, 0x7b, 0x98,
+	0x6f, 0xdd, 0x8c, 0x7f, 0xfa, 0x48, 0x1a, 0x78, 0x27, 0x3e, 0x12, 0x4b, 0x0e, 0xa2, 0x0b, 0x6c,
+	0x2e, 0x5c, 0xf9, 0x0b, 0x01, 0x8c, 0x47, 0xd6, 0x03, 0xbe, 0x0d, 0xf8, 0xa4, 0x56, 0x35, 0xc3,
+	0x70, 0xb0, 0xcb, 0x1c, 0x38, 0xa1, 0x2c, 0x04, 0x63, 0x89, 0xf6, 0xcb, 0x68, 0x9c, 0x35, 0x64,
+	0xd8, 0x33, 0xdc, 0x07, 0x17, 0xf8, 0x0a, 0x89, 0x31, 0xea, 0x08, 0x0b, 0xed, 0x15, 0xd0, 0x5c,
+	0xdc, 0x9e, 0xff, 0xac, 0x6d, 0x5a, 0x8a, 0xc2, 0x67, 0x69, 0x82, 0x31, 0x73, 0x9c, 0xfc, 0xd9,
+	0x97, 0xd2, 0xd2, 0x8e, 0xe9, 0xed, 0x36, 0xb7, 0x96, 0x75, 0xbb, 0xce, 0xf7, 0x32, 0xff, 0xf3,
+	0xb2, 0x6b, 0x3c, 0x5c, 0xf1, 0x0e, 0x1b, 0xd8, 0xa5, 0x14, 0x2e, 0xf2, 0xdf, 0x76, 0x33, 0xfe,
+	0xc1, 0x23, 0x69, 0x40, 0xfe, 0x4c, 0x00, 0x33, 0xdd, 0x76, 0xc3, 0x37, 0x30, 0xb2, 0x77, 0x40,
+	0x9c, 0x3a, 0x56, 0x8c, 0x3a, 0xd6, 0x73, 0x3d, 0x1c, 0xab, 0x63, 0x13, 0x4e, 0x9e, 0xb4, 0xa4,
+	0x51, 0x46, 0xcf, 0xfc, 0x8a, 0x72, 0x70, 0xb1, 0x9f, 0xcc, 0x81, 0x61, 0x85, 0xc2, 0xe1, 0x55,
+	0x10, 0xb7, 0xb4, 0x3a, 0xe6, 0xa2, 0x42, 0x28, 0xd2, 0x2a, 0x23, 0xda, 0x09, 0xdf, 0x05, 0x71,
+	0x47, 0xf3, 0x98, 0x82, 0x84, 0x72, 0x8b, 0xcc, 0xde, 0xdf, 0x5a, 0xd2, 0xb5, 0xa7, 0x98, 0xab,
+	0x1c, 0xd6, 0x03, 0x4a, 0xc2, 0x21, 0x23, 0x4a, 0xd5, 0x65, 0x5a, 0x06, 0xcf, 0x39, 0x2d, 0x45,
+	0x30, 0x6d, 0x60, 0xd7, 0x33, 0x2d, 0xea, 0xc7, 0x6d, 0x9a, 0x38, 0xa5, 0x59, 0x3c, 0x69, 0x49,
+	0x29, 0x46, 0xd3, 0xc5, 0x48, 0x46, 0x30, 0xd4, 0xea, 0x13, 0xde, 0x07, 0xc0, 0xf5, 0x34, 0xc7,
+	0xab, 0x92, 0x63, 0x98, 0x6e, 0xe3, 0xd1, 0x1b, 0xa9, 0x53, 0x5b, 0xad, 0xe2, 0x9f, 0xd1, 0xca,
+	0x65, 0xee, 0x45, 0x7c, 0x13, 0x07, 0x58, 0xf9, 0x63, 0xb2, 0xcf, 0x12, 0xb4, 0x81, 0x98, 0x43,
+	0x04, 0x46, 0xb0, 0x65, 0x30, 0xde, 0xe1, 0xbe, 0xbc, 0x17, 0x39, 0xef, 0x24, 0xdf, 0xc3, 0x96,
+	0x11, 0x62, 0xbd, 0x80, 0x2d, 0x83, 0x72, 0xae, 0x82, 0x38, 0x99, 0x62, 0xba, 0x47, 0x27, 0x6e,
+	0x5c, 0xe9, 0xe1, 0x15, 0x6c, 0x95, 0x2b, 0x87, 0x8d, 0x88, 0x47, 0x10, 0xa0, 0x8c, 0x28, 0x1e,
+	0x7e, 0x20, 0x80, 0x61, 0xad, 0x6e, 0x37, 0x2d, 0x4f, 0x1c, 0xe9, 0xb7, 0x6f, 0x36, 0xf9, 0xa9,
+	0x99, 0x64, 0x80, 0xc8, 0x61, 0x39, 0xce, 0xa8, 0x59, 0xcf, 0xf9, 0xb6, 0x12, 0x7f, 0x3f, 0xdc,
+	0x03, 0xa3, 0x06, 0xb6, 0xec, 0x7a, 0x95, 0x78, 0x88, 0x2b, 0x26, 0xa8, 0x9c, 0x5e, 0x07, 0x69,
+	0x8e, 0x58, 0x22, 0xcd, 0xc3, 0xca, 0x6b, 0x5c, 0xd5, 0x6c, 0x08, 0x1c, 0x91, 0x06, 0x7d, 0x47,
+	0x68, 0x77, 0xcb, 0x08, 0x18, 0x3e, 0xde, 0x25, 0xbe, 0xa8, 0xd5, 0x6a, 0xf6, 0x3e, 0x36, 0xaa,
+	0xb4, 0xd5, 0x15, 0x41, 0x7a, 0x30, 0xea, 0x8b, 0xd1, 0x7e, 0x19, 0x8d, 0xf3, 0x06, 0xaa, 0xc2,
+	0x85, 0xb7, 0xc0, 0xb8, 0x81, 0x2d, 0x33, 0x20, 0x18, 0xa5, 0x04, 0xe2, 0x49, 0x4b, 0x9a, 0x69,
+	0xbf, 0xdc, 0x0c, 0xe1, 0xc7, 0xd8, 0x33, 0x87, 0xff, 0x4c, 0x00, 0x63, 0x35, 0x73, 0x1b, 0x93,
+	0x65, 0xae, 0xea, 0x5a, 0x43, 0x1c, 0xeb, 0xb7, 0x12, 0x1a, 0x1f, 0xf3, 0x5c, 0x18, 0x16, 0x19,
+	0x34, 0xbf, 0xf6, 0xc3, 0xfd, 0xe7, 0x5b, 0x95, 0x51, 0x1f, 0x9a, 0xd5, 0x1a, 0xf0, 0x17, 0x02,
+	0x48, 0xd6, 0xb5, 0x83, 0x2a, 0xbb, 0x50, 0xb8, 0xbf, 0x8c, 0xf7, 0x53, 0x69, 0x72, 0x95, 0xa9,
+	0x4e, 0x68, 0x44, 0xe9, 0x3c, 0x3f, 0xa6, 0x3a, 0x6c, 0xce, 0xa7, 0x76, 0xa2, 0xae, 0x1d, 0xd0,
+	0xbb, 0x35, 0xc3, 0x7c, 0x89, 0x0a, 0x36, 0xad, 0xa8, 0xe0, 0x89, 0xa7, 0x17, 0x6c, 0x5a, 0xfd,
+	0x05, 0x9b, 0xd6, 0x33, 0x09, 0x36, 0xad, 0xb0, 0xe0, 0x1f, 0x08, 0x60, 0x2c, 0x74, 0x28, 0xb9,
+	0xe2, 0x24, 0x15, 0xbb, 0x74, 0xe6, 0xc6, 0xce, 0x05, 0x00, 0xe5, 0x75, 0xdf, 0x25, 0xc2, 0x2c,
+	0xdd, 0x5c, 0x22, 0xdc, 0x4f, 0x3d, 0x31, 0x78, 0x84, 0x15, 0x30, 0xe2, 0xea, 0xbb, 0xd8, 0x68,
+	0xd6, 0xb0, 0x98, 0xa4, 0x27, 0xd5, 0xd5, 0x1e, 0x02, 0xc8, 0xd6, 0x29, 0x73, 0x53, 0x65, 0x3a,
+	0x38, 0xae, 0x7c, 0xb8, 0x8c, 0xda, 0x4c, 0xb0, 0x02, 0xc6, 0xd8, 0xe9, 0xb8, 0x8b, 0xcd, 0x9d,
+	0x5d, 0x4f, 0x9c, 0x4a, 0x0b, 0x4b, 0x83, 0xca, 0xab, 0x44, 0x6c, 0xb8, 0xbd, 0x9b, 0xd8, 0x70,
+	0xbf, 0x8c, 0x46, 0xe9, 0xe3, 0x1a, 0x7d, 0x82, 0xeb, 0x00, 0x90, 0xb3, 0x91, 0x73, 0x42, 0xca,
+	0xf9, 0xf2, 0x93, 0x96, 0x34, 0x13, 0xb4, 0x46, 0x18, 0xa7, 0x82, 0xf3, 0xd4, 0xe7, 0x4b, 0x60,
+	0xcb, 0xe0, 0x6c, 0xf7, 0x01, 0x70, 0xb0, 0xde, 0x74, 0x1c, 0x6c, 0xe9, 0x58, 0x9c, 0xa6, 0x63,
+	0xef, 0x75, 0xaa, 0xa2, 0xb6, 0x61, 0x38, 0x8a, 0x0b, 0xe0, 0x32, 0x0a, 0x71, 0x41, 0x15, 0x8c,
+	0x34, 0x1c, 0xd3, 0x76, 0x4c, 0xef, 0x50, 0x9c, 0x49, 0x0b, 0x4b, 0x43, 0xca, 0x0b, 0x4f, 0x5a,
+	0x12, 0xf4, 0xdb, 0x22, 0x1a, 0xf9, 0x24, 0xfa, 0x7d, 0x32, 0x6a, 0x43, 0xe1, 0x87, 0x42, 0x10,
+	0xe1, 0xcc, 0xf6, 0x73, 0xe4, 0x7b, 0xdc, 0x19, 0xa6, 0x38, 0x22, 0xf2, 0x92, 0x6f, 0x22, 0xec,
+	0x81, 0xb7, 0xc0, 0x70, 0x43, 0x6b, 0xba, 0xd8, 0x10, 0xe7, 0xd2, 0xc2, 0xd2, 0x88, 0xf2, 0x1c,
+	0xb9, 0x17, 0x58, 0x4b, 0xb7, 0x7b, 0x81, 0xf5, 0xc8, 0x88, 0x83, 0xe0, 0x77, 0x01, 0xd0, 0x6d,
+	0xcb, 0x30, 0x99, 0xaf, 0xcf, 0xd3, 0xe9, 0x7e, 0xfe, 0x4c, 0x5f, 0xcf, 0xb6, 0xcd, 0xc3, 0x93,
+	0x1e, 0x90, 0xc8, 0x28, 0xc4, 0x48, 0x5c, 0x2e, 0x92, 0x0f, 0x89, 0x34, 0x1f, 0xa2, 0x2e, 0x17,
+	0x6e, 0xef, 0xe6, 0x72, 0x67, 0x64, 0x4a, 0x6d, 0x56, 0x7b, 0x7b, 0xdb, 0xc5, 0x9e, 0xb8, 0xd0,
+	0xc9, 0xca, 0xda, 0x7b, 0xb3, 0xb2, 0x7e, 0x9f, 0xb5, 0x48, 0x9f, 0xe0, 0xbb, 0xe4, 0xde, 0x73,
+	0x75, 0xc7, 0x6c, 0xd0, 0x20, 0x3f, 0x45, 0x23, 0x98, 0x15, 0x76, 0xa3, 0xb5, 0x9b, 0xbb, 0xdf,
+	0x68, 0xed, 0x6e, 0x19, 0x85, 0x39, 0xe0, 0xeb, 0x20, 0x5e, 0x33, 0xad, 0x87, 0xe2, 0x45, 0xca,
+	0x75, 0xe5, 0x49, 0x4b, 0x9a, 0x20, 0xcf, 0x11, 0x92, 0x51, 0xff, 0x86, 0xb0, 0x1e, 0xca, 0x88,
+	0x9a, 0xc3, 0x35, 0x90, 0x20, 0x7f, 0xab, 0xbb, 0x9a, 0xbb, 0x2b, 0x5e, 0xa2, 0xd8, 0x17, 0x9f,
+	0xb4, 0xa4, 0xe9, 0x76, 0x63, 0x84, 0x20, 0x19, 0x10, 0xd0, 0x4e, 0x19, 0x8d, 0x90, 0xdf, 0x6b,
+	0x9a, 0xbb, 0x0b, 0xdf, 0x04, 0x43, 0xf6, 0xbe, 0x85, 0x1d, 0xf1, 0x32, 0x65, 0xb9, 0xfa, 0xa4,
+	0x25, 0x4d, 0xd2, 0x86, 0x08, 0xc3, 0x18, 0x63, 0xa0, 0x1d, 0x32, 0x62, 0x08, 0xa2, 0xdd, 0xd3,
+	0x76, 0x5c, 0x71, 0x31, 0x3d, 0xe8, 0x6b, 0x27, 0xcf, 0xdd, 0xb4, 0x93, 0x76, 0x12, 0xc8, 0x68,
+	0x3b, 0x2e, 0x7c, 0x0d, 0xc4, 0x4c, 0x43, 0x94, 0x58, 0xca, 0xf2, 0xb8, 0x25, 0xc5, 0xf2, 0xb9,
+	0x27, 0x2d, 0x69, 0xcc, 0x8c, 0xba, 0x63, 0x82, 0x01, 0x4d, 0x43, 0x46, 0x31, 0xd3, 0xb8, 0x39,
+	0x42, 0xe2, 0x61, 0x92, 0xa2, 0xc8, 0x7f, 0x1a, 0x02, 0xc9, 0x4e, 0x47, 0x83, 0xbf, 0x15, 0x00,
+	0x24, 0xc7, 0x3c, 0x0f, 0x45, 0xb7, 0xb4, 0x9a, 0x46, 0x4e, 0x07, 0xa1, 0xdf, 0xf6, 0xab, 0xf3,
+	0xed, 0x77, 0xe9, 0x34, 0x38, 0xa2, 0x66, 0x21, 0xb8, 0x49, 0xa2, 0x56, 0xe7, 0xdb, 0x94, 0xe4,
+	0xa6, 0x63, 0xc9, 0x87, 0xc2, 0xe0, 0xf0, 0x2f, 0x02, 0x98, 0x27, 0x17, 0x6a, 0x38, 0xf8, 0xf5,
+	0xd5, 0xf7, 0x4d, 0x8f, 0xf6, 0xfd, 0x04, 0xbe, 0x07, 0x43, 0xb7, 0x04, 0xbe, 0x87, 0xe9, 0xf9,
+	0xc6, 0x31, 0x5b, 0xd7, 0x0e, 0xc2, 0x57, 0x1a, 0x1f, 0xcc, 0x27, 0xfc, 0x2e, 0xdf, 0xb2, 0x2d,
+	0x03, 0x1b, 0x55, 0x9a, 0xb8, 0xf2, 0x74, 0x61, 0xe7, 0xf3, 0x96, 0x24, 0x3c, 0x7d, 0x2e, 0xe2,
+	0x5f, 0xed, 0x61, 0xa6, 0x5e, 0x57, 0x7b, 0xd8, 0x46, 0xa6, 0xd7, 0xb5, 0x42, 0x5b, 0x10, 0x69,
+	0x60, 0x9a, 0xb4, 0x83, 0xa8, 0xa6, 0xf8, 0xd7, 0xd6, 0xa4, 0x1d, 0xf4, 0xd7, 0xa4, 0x1d, 0x9c,
+	0xd2, 0xa4, 0x1d, 0x84, 0x34, 0xf1, 0xe4, 0xee, 0xd7, 0x02, 0x00, 0xc1, 0x05, 0x45, 0xb2, 0x47,
+	0x9a, 0x27, 0x08, 0x67, 0x66, 0x8f, 0x01, 0xe0, 0xac, 0x5c, 0x01, 0x81, 0x11, 0xd3, 0xf2, 0xb0,
+	0xb3, 0xa7, 0xd5, 0x68, 0x2e, 0x78, 0x66, 0x29, 0xa2, 0x23, 0x8d, 0xf1, 0x81, 0xac, 0x08, 0xd1,
+	0xe6, 0xe1, 0xa2, 0x7f, 0x18, 0x03, 0x63, 0xe1, 0x88, 0x02, 0xae, 0x45, 0x64, 0xf7, 0x0a, 0x42,
+	0x7c, 0xf3, 0xb3, 0x44, 0xef, 0x80, 0xe1, 0x86, 0x6d, 0x5a, 0x9e, 0x2b, 0xc6, 0xce, 0x2e, 0x10,
+	0x71, 0xae, 0x12, 0x31, 0x56, 0x5e, 0xf0, 0x53, 0x1d, 0x86, 0xed, 0x7a, 0xa5, 0xd1, 0x1e, 0x72,
+	0xa5, 0xd1, 0x1f, 0x70, 0x1d, 0x0c, 0x37, 0xb0, 0x63, 0xda, 0x86, 0x38, 0xd8, 0x6f, 0x6e, 0x16,
+	0xf8, 0xdc, 0xf8, 0x4c, 0x14, 0xc6, 0x66, 0x86, 0x73, 0xf0, 0x79, 0xf9, 0x1d, 0xa9, 0x94, 0x84,
+	0x85, 0xc1, 0xdb, 0x20, 0x4e, 0xf3, 0x48, 0xa1, 0x6f, 0x1e, 0x39, 0xcf, 0x5f, 0xe2, 0xcf, 0x49,
+	0x3b, 0x87, 0xa4, 0x04, 0xf0, 0x1e, 0x18, 0xde, 0xd6, 0x74, 0xcf, 0x76, 0x78, 0x5a, 0xff, 0xff,
+	0xe7, 0x4e, 0xeb, 0xb9, 0x7a, 0xc6, 0x22, 0x23, 0x4e, 0xc7, 0x95, 0xff, 0x3e, 0x0e, 0xa6, 0x4e,
+	0x05, 0xa9, 0xf0, 0x25, 0x70, 0x21, 0x5a, 0x06, 0x81, 0x41, 0x3c, 0xd2, 0x4e, 0xce, 0x7d, 0x13,
+	0x22, 0x71, 0x9f, 0x45, 0x77, 0xcf, 0x28, 0x71, 0x9f, 0xc7, 0x7b, 0x9c, 0x0e, 0xbe, 0xc7, 0xbd,
+	0x6b, 0x90, 0x7a, 0xd7, 0xb5, 0x9e, 0x29, 0x66, 0x5b, 0x38, 0x75, 0x30, 0x76, 0x15, 0x1d, 0x36,
+	0x70, 0xd7, 0xab, 0x28, 0xe4, 0x72, 0x1b, 0x00, 0xec, 0x69, 0x35, 0xd3, 0xd0, 0x3c, 0xdb, 0x61,
+	0x75, 0xc9, 0x04, 0x8b, 0x4c, 0x83, 0xd6, 0x6e, 0x91, 0x69, 0xd0, 0x2b, 0xa3, 0x10, 0x01, 0xfc,
+	0x1e, 0x98, 0x74, 0xf0, 0xbe, 0xe6, 0x18, 0x6e, 0xbb, 0xca, 0x31, 0x44, 0xe7, 0xe3, 0x0d, 0x52,
+	0xc1, 0xec, 0xe8, 0xea, 0x56, 0xc1, 0xec, 0x30, 0x91, 0xd1, 0x04, 0x6f, 0xf1, 0x4b, 0x1f, 0xef,
+	0x0b, 0x60, 0xcc, 0xdc, 0xd2, 0xab, 0x9e, 0xa3, 0x59, 0xee, 0x36, 0x76, 0x78, 0x95, 0x42, 0xee,
+	0x31, 0x31, 0x79, 0x25, 0x5b, 0xe1, 0x96, 0xca, 0xdb, 0x8f, 0x5b, 0xd2, 0x68, 0xa8, 0x81, 0xc4,
+	0x42, 0x61, 0xaa, 0x6e, 0xb1, 0x50, 0xb8, 0x5f, 0x46, 0xa3, 0xe6, 0x96, 0xee, 0xa3, 0xb9, 0xf3,
+	0xbc, 0x3f, 0x08, 0xc2, 0x9c, 0xf0, 0x0d, 0x30, 0xea, 0x57, 0x88, 0x6d, 0xc7, 0xe3, 0xae, 0x33,
+	0x17, 0x04, 0x42, 0xa1, 0x4e, 0x19, 0x01, 0xf6, 0x54, 0xb2, 0x1d, 0x2f, 0x54, 0x66, 0xd2, 0x77,
+	0x35, 0xcb, 0xc2, 0x35, 0xee, 0x49, 0xa7, 0xcb, 0x4c, 0xbc, 0xbf, 0x5d, 0x66, 0xca, 0xb2, 0x67,
+	0xb8, 0x02, 0x46, 0x1c, 0xac, 0x63, 0x73, 0x0f, 0x3b, 0xfc, 0xce, 0x09, 0x25, 0x3b, 0x7e, 0x8f,
+	0x8c, 0xda, 0x46, 0xb0, 0x08, 0x2e, 0x90, 0xfd, 0x65, 0x37, 0x3d, 0x31, 0xde, 0xef, 0x1c, 0x48,
+	0x45, 0x0b, 0x91, 0x1c, 0xc7, 0x0e, 0x02, 0x9f, 0x05, 0x36, 0x01, 0xb0, 0xad, 0xea, 0xb6, 0x66,
+	0xd6, 0x9a, 0x8e, 0x5f, 0x5e, 0x7e, 0xbe, 0xf7, 0xca, 0xac, 0x32, 0xc3, 0x8c, 0x4e, 0xdf, 0x40,
+	0xdd, 0x2e, 0x80, 0x77, 0x73, 0xbb, 0xa0, 0x57, 0x46, 0x09, 0xdb, 0xe2, 0x78, 0xbe, 0x12, 0x1f,
+	0x0a, 0x20, 0xd1, 0x2e, 0xb5, 0xc0, 0x6b, 0x60, 0x88, 0x56, 0x30, 0xf8, 0x0a, 0x24, 0x83, 0x10,
+	0x8e, 0x36, 0xcb, 0x88, 0x75, 0xff, 0x07, 0x0a, 0x86, 0x5c, 0xce, 0x1f, 0x04, 0x30, 0x5d, 0xb1,
+	0x3d, 0xad, 0x96, 0xb5, 0x6b, 0x35, 0xac, 0x7b, 0xd8, 0xa0, 0x91, 0x03, 0xa9, 0xa0, 0xcc, 0x7a,
+	0xa4, 0xbd, 0xaa, 0xfb, 0x1d, 0x55, 0xf2, 0xa5, 0xc5, 0xed, 0x1f, 0xab, 0x95, 0xf8, 0x1a, 0x5c,
+	0xe2, 0x6b, 0xd0, 0x8d, 0xe5, 0x7c, 0x61, 0xcc, 0xb4, 0x77, 0x5a, 0x21, 0xd7, 0xff, 0x2b, 0x01,
+	0x24, 0xa9, 0x7e, 0xa5, 0xe9, 0x58, 0xbe, 0xf8, 0x1f, 0x09, 0x00, 0xb2, 0xd7, 0x6e, 0xd1, 0xd6,
+	0xa7, 0x55, 0xbe, 0xc1, 0x95, 0x2f, 0x84, 0x95, 0x87, 0x29, 0xce, 0x19, 0x45, 0x7a, 0x1d, 0xc2,
+	0xb8, 0xe6, 0x1f, 0x0b, 0x20, 0x81, 0x70, 0x5d, 0x33, 0xc9, 0xc7, 0x33, 0x52, 0xa7, 0x48, 0x38,
+	0xfe, 0x13, 0xd7, 0x78, 0xa9, 0xab, 0xc6, 0x1c, 0xd6, 0xa9, 0xcc, 0xdb, 0x5c, 0x66, 0xd2, 0xdf,
+	0x33, 0x1c, 0x4c, 0xd4, 0xbd, 0xf8, 0x74, 0x2e, 0xc1, 0x04, 0x06, 0xef, 0xe5, 0xca, 0xfe, 0x38,
+	0x04, 0x26, 0x32, 0x8e, 0xbe, 0x6b, 0xee, 0x61, 0x83, 0xd7, 0xb3, 0xdf, 0x02, 0xc3, 0x6c, 0x4b,
+	0xf0, 0x0b, 0xf2, 0xf2, 0x99, 0x39, 0xa5, 0x12, 0x27, 0xda, 0x10, 0x87, 0xc0, 0x02, 0x18, 0x76,
+	0x3d, 0xcd, 0x6b, 0xba, 0x62, 0xec, 0xcc, 0xb0, 0x83, 0x81, 0xcb, 0xd4, 0x54, 0x99, 0x0a, 0xae,
+	0x19, 0x06, 0x96, 0x11, 0x67, 0x81, 0x59, 0x30, 0xa9, 0x71, 0x79, 0x7e, 0x99, 0x62, 0x90, 0x96,
+	0x29, 0x52, 0xc1, 0xd9, 0xdc, 0x61, 0x20, 0xa3, 0x09, 0xbf, 0x85, 0x17, 0x26, 0x34, 0x30, 0xde,
+	0xb6, 0xa1, 0x37, 0x7f, 0xbc, 0xef, 0xcd, 0x9f, 0xe6, 0x33, 0x3e, 0xd3, 0xf1, 0x8a, 0x20, 0x04,
+	0x18, 0xf3, 0xdb, 0x08, 0xe8, 0x8c, 0xdd, 0x33, 0xf4, 0xed, 0xd8, 0x3d, 0xf0, 0xa7, 0x02, 0xb8,
+	0x18, 0x4e, 0x2f, 0x3a, 0x75, 0x0e, 0x53, 0x9d, 0xaf, 0xf4, 0xbf, 0xc8, 0xa3, 0xbc, 0xca, 0x75,
+	0x2e, 0x5f, 0x3e, 0xfd, 0xad, 0xa0, 0x73, 0x10, 0x68, 0xc1, 0xe8, 0x45, 0x03, 0x6f, 0x80, 0x44,
+	0xd3, 0x22, 0xc1, 0xb9, 0x69, 0xed, 0xd0, 0x8a, 0xfc, 0x88, 0x32, 0x13, 0x78, 0x7c, 0xbb, 0x4b,
+	0x46, 0x81, 0x19, 0x77, 0xe1, 0x7f, 0x0e, 0x82, 0x24, 0x27, 0x33, 0x6d, 0x0b, 0x61, 0xdd, 0x76,
+	0x0c, 0x78, 0x0b, 0x24, 0xd8, 0x08, 0xaa, 0xa6, 0x41, 0xfd, 0x38, 0xae, 0xa4, 0x1f, 0xb7, 0xa4,
+	0x11, 0xe6, 0x77, 0xf9, 0x5c, 0x40, 0xdd, 0x36, 0x93, 0xd1, 0x08, 0xfb, 0x9d, 0x37, 0xe0, 0x0b,
+	0x60, 0x78, 0x37, 0x08, 0x9b, 0x06, 0xc3, 0x1e, 0xea, 0x3b, 0x19, 0x37, 0x68, 0x47, 0x93, 0x83,
+	0xcf, 0x1a, 0x4d, 0x7e, 0x14, 0x7c, 0x90, 0xe5, 0xa9, 0x9f, 0xff, 0x41, 0xf6, 0x0c, 0xe7, 0x79,
+	0x87, 0x73, 0x46, 0x3f, 0xb4, 0xfa, 0xf8, 0x73, 0xd6, 0x53, 0xdd, 0x70, 0x02, 0xec, 0x52, 0x41,
+	0xe7, 0xf6, 0xe6, 0x0e, 0x41, 0xcf, 0xe4, 0xc7, 0x13, 0x7a, 0xb7, 0x0b, 0xe0, 0xd3, 0x18, 0x58,
+	0xe8, 0xe9, 0x8e, 0xbd, 0xbe, 0x69, 0x09, 0x5f, 0xfb, 0x9b, 0x56, 0xef, 0x9d, 0x1d, 0xfb, 0x96,
+	0xdc, 0x8b, 0xb4, 0x02, 0xf3, 0x8f, 0x47, 0x92, 0x70, 0xbd, 0x0e, 0x12, 0xed, 0xaf, 0xe3, 0xf0,
+	0x3a, 0x98, 0x52, 0x4b, 0xc5, 0xec, 0x5a, 0x75, 0xa3, 0x98, 0x53, 0xab, 0xca, 0x7a, 0x31, 0x7b,
+	0xa7, 0x9c, 0x1c, 0x48, 0x4d, 0x1f, 0x1d, 0xa7, 0x27, 0xdb, 0x56, 0xbc, 0x2a, 0xb7, 0x0c, 0xa6,
+	0x43, 0xb6, 0xb9, 0x4d, 0x94, 0xa9, 0xe4, 0x8b, 0x85, 0xa4, 0x90, 0x9a, 0x3d, 0x3a, 0x4e, 0x4f,
+	0xb5, 0xad, 0xfd, 0xa8, 0x2b, 0x15, 0xff, 0xe0, 0xe7, 0x8b, 0x03, 0xd7, 0x7f, 0x22, 0x80, 0x89,
+	0x8e, 0x6f, 0xb5, 0x2a, 0x90, 0x4a, 0xa8, 0x98, 0x55, 0xcb, 0xe5, 0x7c, 0xe1, 0x36, 0x63, 0x2b,
+	0xaf, 0x65, 0x90, 0x9a, 0xab, 0x96, 0x0b, 0x99, 0x52, 0x79, 0xad, 0x58, 0x49, 0x0e, 0xa4, 0xd2,
+	0x47, 0xc7, 0xe9, 0x4b, 0x51, 0x60, 0x79, 0x57, 0x73, 0xb0, 0x51, 0xb6, 0xb4, 0x86, 0xbb, 0x6b,
+	0x7b, 0xf0, 0xff, 0x40, 0xea, 0x14, 0x8d, 0xfa, 0xee, 0xa6, 0x5a, 0xa8, 0xe4, 0x33, 0xeb, 0x49,
+	0x21, 0x75, 0xe9, 0xe8, 0x38, 0x2d, 0x76, 0x30, 0xe0, 0xef, 0x37, 0xb1, 0xe5, 0x99, 0x5a, 0x8d,
+	0xab, 0xfb, 0x73, 0x0c, 0x4c, 0x76, 0xe4, 0x1f, 0xf0, 0x7f, 0x81, 0x98, 0x53, 0xcb, 0x95, 0x7c,
+	0x81, 0x8e, 0xaf, 0x5a, 0x79, 0x50, 0x52, 0xab, 0x99, 0x6c, 0xb6, 0xb8, 0x59, 0x20, 0xba, 0x52,
+	0x47, 0xc7, 0xe9, 0xb9, 0x0e, 0x48, 0x46, 0xd7, 0xe9, 0xc7, 0x05, 0x15, 0x48, 0xa7, 0x90, 0xd9,
+	0xe2, 0xc6, 0xc6, 0x66, 0x21, 0x5f, 0x79, 0x50, 0x2d, 0x15, 0x8b, 0x44, 0x16, 0x1d, 0x58, 0x07,
+	0x41, 0xd6, 0xae, 0xd7, 0x9b, 0x96, 0xe9, 0x1d, 0x96, 0x6c, 0xbb, 0x06, 0x6f, 0x80, 0xd9, 0x53,
+	0x34, 0xca, 0x26, 0x2a, 0x24, 0x63, 0xa9, 0xf9, 0xa3, 0xe3, 0xf4, 0x74, 0x07, 0x98, 0x84, 0x12,
+	0x5d, 0x45, 0x97, 0x2b, 0x99, 0x3b, 0xf9, 0xc2, 0xed, 0xe4, 0x60, 0x57, 0xd1, 0x65, 0x4f, 0x7b,
+	0x68, 0x5a, 0x3b, 0x30, 0x03, 0x2e, 0x9f, 0x42, 0xe6, 0x95, 0x6c, 0xb5, 0x82, 0x32, 0x85, 0xf2,
+	0xaa, 0x8a, 0x92, 0xf1, 0xd4, 0xe2, 0xd1, 0x71, 0x3a, 0xd5, 0x01, 0x0f, 0xe5, 0x0d, 0x7c, 0x2e,
+	0x3f, 0x12, 0x40, 0xb2, 0x33, 0x30, 0x86, 0x6f, 0x82, 0x05, 0x42, 0xb6, 0x9a, 0xc9, 0xaf, 0x6f,
+	0x22, 0x32, 0x8f, 0xf4, 0x25, 0x48, 0x5d, 0xdd, 0x2c, 0xe4, 0xfc, 0xd9, 0xec, 0x04, 0x21, 0xbc,
+	0xdd, 0xb4, 0x8c, 0x1e, 0x50, 0xb5, 0x9c, 0x45, 0xc5, 0x7b, 0x49, 0xa1, 0x3b, 0x54, 0x75, 0x75,
+	0xc7, 0xde, 0xe7, 0x82, 0xfe, 0x25, 0x80, 0xb1, 0x70, 0xe9, 0x82, 0x78, 0x70, 0x39, 0xbb, 0xa6,
+	0xe6, 0x36, 0xd7, 0x55, 0x7f, 0x86, 0xd4, 0x12, 0xf1, 0x77, 0xea, 0xc1, 0x61, 0xd3, 0xb2, 0x87,
+	0x1b, 0x2e, 0x7c, 0x05, 0xcc, 0x44, 0xed, 0xd7, 0xf3, 0x05, 0x35, 0x83, 0x92, 0x42, 0x6a, 0xee,
+	0xe8, 0x38, 0x0d, 0xc3, 0x80, 0x75, 0xd3, 0xc2, 0x9a, 0x43, 0x3c, 0x20, 0x8a, 0x50, 0xef, 0x97,
+	0x8a, 0x05, 0xe6, 0x92, 0xd5, 0x9c, 0x9a, 0xcd, 0x3c, 0x48, 0xc6, 0x98, 0x07, 0x84, 0xc1, 0xea,
+	0x41, 0xc3, 0xb6, 0x98, 0x5f, 0xe6, 0xb0, 0xae, 0x1d, 0x12, 0x0f, 0x88, 0xd2, 0xac, 0x65, 0xd6,
+	0xef, 0xb2, 0xa5, 0xa4, 0x1e, 0x10, 0x06, 0xaf, 0x69, 0xb5, 0x3d, 0xd3, 0xda, 0xe1, 0x63, 0x6e,
+	0x02, 0x10, 0x7c, 0x8c, 0x86, 0x4b, 0x20, 0xa9, 0x6c, 0xe6, 0x6e, 0xab, 0x15, 0xc6, 0x82, 0x32,
+	0x15, 0x35, 0x39, 0x90, 0x82, 0x47, 0xc7, 0xe9, 0x89, 0xc0, 0x8a, 0xa6, 0x1c, 0x6f, 0x00, 0x31,
+	0x6c, 0xb9, 0x9a, 0xbf, 0xaf, 0xe6, 0xaa, 0x99, 0x0d, 0xea, 0xf4, 0x42, 0x6a, 0xe1, 0xe8, 0x38,
+	0x3d, 0x1b, 0x20, 0x56, 0xcd, 0x03, 0x6c, 0xb0, 0x0f, 0x6a, 0xfc, 0xb5, 0x27, 0x02, 0x98, 0x88,
+	0x16, 0xb7, 0xc8, 0x18, 0x90, 0x9a, 0xdd, 0x44, 0x48, 0x2d, 0x64, 0xf9, 0x28, 0x72, 0x99, 0xfc,
+	0xfa, 0x83, 0xe4, 0x00, 0x1b, 0x43, 0xd4, 0x3c, 0xa7, 0x99, 0xb5, 0x43, 0xf8, 0xdf, 0x60, 0xae,
+	0x13, 0x73, 0x4f, 0x55, 0xef, 0xac, 0x3f, 0x48, 0x0a, 0x29, 0xf1, 0xe8, 0x38, 0x3d, 0x13, 0x05,
+	0xdd, 0xc3, 0xf8, 0x61, 0xed, 0x10, 0xfe, 0x0f, 0x98, 0xef, 0x44, 0x6d, 0x14, 0x0b, 0x95, 0xb5,
+	0x75, 0x32, 0xd9, 0x54, 0x7a, 0x14, 0xb6, 0x61, 0x5b, 0xde, 0x6e, 0xed, 0x90, 0xec, 0x99, 0x4e,
+	0x5c, 0xbe, 0x50, 0x51, 0xd1, 0xdd, 0xcc, 0xba, 0xbf, 0x67, 0xa2, 0xc0, 0x3c, 0xaf, 0xa6, 0xf1,
+	0x41, 0x3f, 0x8a, 0x81, 0xb1, 0x70, 0x8c, 0x0a, 0x6f, 0x82, 0x05, 0x3e, 0x89, 0xe5, 0x4a, 0xa6,
+	0xb2, 0x59, 0xae, 0x6e, 0x16, 0xca, 0x25, 0x35, 0x9b, 0x5f, 0xcd, 0xab, 0xc4, 0xd9, 0x2f, 0x1e,
+	0x1d, 0xa7, 0xe7, 0xc3, 0x80, 0x4d, 0xcb, 0x6d, 0x60, 0xdd, 0xdc, 0x36, 0xb1, 0x41, 0x86, 0xde,
+	0x81, 0x2d, 0x65, 0x8b, 0x1b, 0x64, 0xcd, 0xf9, 0xd0, 0x23, 0xc0, 0x86, 0x6e, 0xd7, 0xc9, 0xe6,
+	0x7d, 0x05, 0xcc, 0x44, 0x51, 0x64, 0x97, 0xdc, 0x55, 0x93, 0x31, 0xe6, 0xa1, 0x61, 0x0c, 0xd9,
+	0x20, 0x7b, 0x74, 0x59, 0xa2, 0x08, 0xf5, 0x7e, 0x29, 0x8f, 0xd4, 0x9c, 0xef, 0x5a, 0x61, 0x88,
+	0x7a, 0xd0, 0x30, 0x1d, 0x6c, 0x9c, 0xc6, 0x20, 0x75, 0xa3, 0x78, 0x57, 0xcd, 0x25, 0xe3, 0xa7,
+	0x31, 0x08, 0xd7, 0xed, 0x3d, 0x6c, 0xb0, 0x29, 0x52, 0xd4, 0xcf, 0x1f, 0x2f, 0x0a, 0x5f, 0x3c,
+	0x5e, 0x14, 0xfe, 0xfe, 0x78, 0x51, 0xf8, 0xf8, 0xab, 0xc5, 0x81, 0x2f, 0xbe, 0x5a, 0x1c, 0xf8,
+	0xeb, 0x57, 0x8b, 0x03, 0xdf, 0x09, 0xa7, 0x24, 0xa7, 0xff, 0x61, 0xf0, 0xc0, 0xff, 0x41, 0x2f,
+	0xb6, 0xad, 0x61, 0x1a, 0x29, 0xbd, 0xf6, 0xef, 0x01, 0x00, 0x8d, 0xd7, 0x4a, 0x09, 0x5b, 0x28,
+	0x00, 0x00,
+}
+
+func (this *DestinationCollectedCoins) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DestinationCollectedCoins)
+	if !ok {
+		that2, ok := that.(DestinationCollectedCoins)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DestinationAddress != that1.DestinationAddress {
+		return false
+	}
+	if len(this.TotalCollectedCoins) != len(that1.TotalCollectedCoins) {
+		return false
+	}
+	for i := range this.TotalCollectedCoins {
+		if !this.TotalCollectedCoins[i].Equal(&that1.TotalCollectedCoins[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HistoryRetentionBlocks != 0 {
+		i = encodeVarintBudget(dAtA, i, uint64(m.HistoryRetentionBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.EpochDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.EpochDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintBudget(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if m.EpochMode != 0 {
+		i = encodeVarintBudget(dAtA, i, uint64(m.EpochMode))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SourceReserves) > 0 {
+		for iNdEx := len(m.SourceReserves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceReserves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBudget(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SourceProcessingModes) > 0 {
+		for iNdEx := len(m.SourceProcessingModes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceProcessingModes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBudget(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EpochBlocks != 0 {
+		i = encodeVarintBudget(dAtA, i, uint64(m.EpochBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SourceReserve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SourceReserve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SourceReserve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reserve) > 0 {
+		for iNdEx := len(m.Reserve) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reserve[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBudget(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SourceAddress) > 0 {
+		i -= len(m.SourceAddress)
+		copy(dAtA[i:], m.SourceAddress)
+		i = encodeVarintBudget(dAtA, i, uint64(len(m.SourceAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SourceProcessingMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SourceProcessingMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SourceProcessingMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mode != 0 {
+		i = encodeVarintBudget(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SourceAddress) > 0 {
+		i -= len(m.SourceAddress)
+		copy(dAtA[i:], m.SourceAddress)
+		i = encodeVarintBudget(dAtA, i, uint64(len(m.SourceAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Budget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Budget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Budget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		i = encodeVarintBudget(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintBudget(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintBudget(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.LinkHash) > 0 {
+		i -= len(m.LinkHash)
+		copy(dAtA[i:], m.LinkHash)
+		i = encodeVarintBudget(dAtA, i, uint64(len(m.LinkHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if len(m.Link) > 0 {
+		i -= len(m.Link)
+		copy(dAtA[i:], m.Link)
+		i = encodeVarintBudget(dAtA, i, uint64(len(m.Link)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintBudget(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.EpochOffset != 0 {
+		i = encodeVarintBudget(dAtA, i, uint64(m.EpochOffset))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.EpochBlocks != 0 {
+		i = encodeVarintBudget(dAtA, i, uint64(m.EpochBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.Conditions != nil {
+		{
+			size, err := m.Conditions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBudget(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.Reserve) > 0 {
+		for iNdEx := len(m.Reserve) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reserve[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBudget(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if m.Priority != 0 {
+		i = encodeVarintBudget(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.Recurrence != nil {
+		{
+			size, err := m.Recurrence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBudget(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintBudget(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintBudget(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBudget(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Destinations) > 0 {
+		for iNdEx := len(m.Destinations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Destinations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBudget(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.MinEpochAmount) > 0 {
+		for iNdEx := len(m.MinEpochAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinEpochAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBudget(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.MaxEpochAmount) > 0 {
+		for iNdEx := len(m.MaxEpochAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxEpochAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBudget(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.LifetimeCap) > 0 {
+		for iNdEx := len(m.LifetimeCap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LifetimeCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBudget(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.DeniedDenoms) > 0 {
+		for iNdEx := len(m.DeniedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedDenoms[iNdEx])
+			copy(dAtA[i:], m.DeniedDenoms[iNdEx])
+			i = encodeVarintBudget(dAtA, i, uint64(len(m.DeniedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintBudget(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DenomRates) > 0 {
+		for iNdEx := len(m.DenomRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBudget(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBudget(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Type != 0 {
+		i = encodeVarintBudget(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x38
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintBudget(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x32
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintBudget(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.DestinationAddress) > 0 {
+		i -= len(m.DestinationAddress)
+		copy(dAtA[i:], m.DestinationAddress)
+		i = encodeVarintBudget(dAtA, i, uint64(len(m.DestinationAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceAddress) > 0 {
+		i -= len(m.SourceAddress)
+		copy(dAtA[i:], m.SourceAddress)
+		i = encodeVarintBudget(dAtA, i, uint64(len(m.SourceAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBudget(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintBudget(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BudgetConditions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BudgetConditions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BudgetConditions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBondedRatio != nil {
+		{
+			size := m.MaxBondedRatio.Size()
+			i -= size
+			if _, err := m.MaxBondedRatio.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBudget(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MinBondedRatio != nil {
+		{
+			size := m.MinBondedRatio.Size()
+			i -= size
+			if _, err := m.MinBondedRatio.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBudget(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MaxDestinationBalance) > 0 {
+		for iNdEx := len(m.MaxDestinationBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxDestinationBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBudget(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MinSourceBalance) > 0 {
+		for iNdEx := len(m.MinSourceBalance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinSourceBalance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBudget(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Recurrence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Recurrence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Recurrence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintBudget(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	if m.Type != 0 {
+		i = encodeVarintBudget(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintBudget(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBudget(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Type != 0 {
+		i = encodeVarintBudget(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchedulePoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulePoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulePoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Factor.Size()
+		i -= size
+		if _, err := m.Factor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBudget(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintBudget(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BudgetDestination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BudgetDestination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BudgetDestination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IBCTransfer != nil {
+		{
+			size, err := m.IBCTransfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBudget(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RewardsAddress) > 0 {
+		i -= len(m.RewardsAddress)
+		copy(dAtA[i:], m.RewardsAddress)
+		i = encodeVarintBudget(dAtA, i, uint64(len(m.RewardsAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintBudget(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Type != 0 {
+		i = encodeVarintBudget(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBudget(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBudget(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IBCTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OnFailure != 0 {
+		i = encodeVarintBudget(dAtA, i, uint64(m.OnFailure))
+		i--
+		dAtA[i] = 0x28
+	}
+	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintBudget(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintBudget(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintBudget(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourcePort) > 0 {
+		i -= len(m.SourcePort)
+		copy(dAtA[i:], m.SourcePort)
+		i = encodeVarintBudget(dAtA, i, uint64(len(m.SourcePort)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBudget(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintBudget(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TotalCollectedCoins) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TotalCollectedCoins) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TotalCollectedCoins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalCollectedCoins) > 0 {
+		for iNdEx := len(m.TotalCollectedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalCollectedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBudget(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TotalBurnedCoins) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TotalBurnedCoins) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TotalBurnedCoins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalBurnedCoins) > 0 {
+		for iNdEx := len(m.TotalBurnedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalBurnedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBudget(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Remainder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Remainder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Remainder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remainder) > 0 {
+		for iNdEx := len(m.Remainder) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remainder[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBudget(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArchivedBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArchivedBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArchivedBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Unbonding {
+		i--
+		if m.Unbonding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.DestinationCollectedCoins) > 0 {
+		for iNdEx := len(m.DestinationCollectedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DestinationCollectedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBudget(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TotalCollectedCoins) > 0 {
+		for iNdEx := len(m.TotalCollectedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalCollectedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBudget(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ArchivedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ArchivedTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintBudget(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x22
+	if m.ArchivedHeight != 0 {
+		i = encodeVarintBudget(dAtA, i, uint64(m.ArchivedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintBudget(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Budget.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBudget(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CollectionRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollectionRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollectionRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollectedCoins) > 0 {
+		for iNdEx := len(m.CollectedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollectedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBudget(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SourceBalances) > 0 {
+		for iNdEx := len(m.SourceBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBudget(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintBudget(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintBudget(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BudgetID != 0 {
+		i = encodeVarintBudget(dAtA, i, uint64(m.BudgetID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DestinationCollectedCoins) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DestinationCollectedCoins) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DestinationCollectedCoins) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalCollectedCoins) > 0 {
+		for iNdEx := len(m.TotalCollectedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalCollectedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBudget(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DestinationAddress) > 0 {
+		i -= len(m.DestinationAddress)
+		copy(dAtA[i:], m.DestinationAddress)
+		i = encodeVarintBudget(dAtA, i, uint64(len(m.DestinationAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBudget(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBudget(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochBlocks != 0 {
+		n += 1 + sovBudget(uint64(m.EpochBlocks))
+	}
+	if len(m.SourceProcessingModes) > 0 {
+		for _, e := range m.SourceProcessingModes {
+			l = e.Size()
+			n += 1 + l + sovBudget(uint64(l))
+		}
+	}
+	if len(m.SourceReserves) > 0 {
+		for _, e := range m.SourceReserves {
+			l = e.Size()
+			n += 1 + l + sovBudget(uint64(l))
+		}
+	}
+	if m.EpochMode != 0 {
+		n += 1 + sovBudget(uint64(m.EpochMode))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.EpochDuration)
+	n += 1 + l + sovBudget(uint64(l))
+	if m.HistoryRetentionBlocks != 0 {
+		n += 1 + sovBudget(uint64(m.HistoryRetentionBlocks))
+	}
+	return n
+}
+
+func (m *SourceReserve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceAddress)
+	if l > 0 {
+		n += 1 + l + sovBudget(uint64(l))
+	}
+	if len(m.Reserve) > 0 {
+		for _, e := range m.Reserve {
+			l = e.Size()
+			n += 1 + l + sovBudget(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SourceProcessingMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceAddress)
+	if l > 0 {
+		n += 1 + l + sovBudget(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovBudget(uint64(m.Mode))
+	}
+	return n
+}
+
+func (m *Budget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovBudget(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovBudget(uint64(l))
+	l = len(m.SourceAddress)
+	if l > 0 {
+		n += 1 + l + sovBudget(uint64(l))
+	}
+	l = len(m.DestinationAddress)
+	if l > 0 {
+		n += 1 + l + sovBudget(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovBudget(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovBudget(uint64(l))
+	if m.Type != 0 {
+		n += 1 + sovBudget(uint64(m.Type))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBudget(uint64(l))
+		}
+	}
+	if len(m.DenomRates) > 0 {
+		for _, e := range m.DenomRates {
+			l = e.Size()
+			n += 1 + l + sovBudget(uint64(l))
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovBudget(uint64(l))
+		}
+	}
+	if len(m.DeniedDenoms) > 0 {
+		for _, s := range m.DeniedDenoms {
+			l = len(s)
+			n += 1 + l + sovBudget(uint64(l))
+		}
+	}
+	if len(m.LifetimeCap) > 0 {
+		for _, e := range m.LifetimeCap {
+			l = e.Size()
+			n += 1 + l + sovBudget(uint64(l))
+		}
+	}
+	if len(m.MaxEpochAmount) > 0 {
+		for _, e := range m.MaxEpochAmount {
+			l = e.Size()
+			n += 1 + l + sovBudget(uint64(l))
+		}
+	}
+	if len(m.MinEpochAmount) > 0 {
+		for _, e := range m.MinEpochAmount {
+			l = e.Size()
+			n += 1 + l + sovBudget(uint64(l))
+		}
+	}
+	if len(m.Destinations) > 0 {
+		for _, e := range m.Destinations {
+			l = e.Size()
+			n += 1 + l + sovBudget(uint64(l))
+		}
+	}
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 2 + l + sovBudget(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 2 + sovBudget(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 2 + sovBudget(uint64(m.EndHeight))
+	}
+	if m.Recurrence != nil {
+		l = m.Recurrence.Size()
+		n += 2 + l + sovBudget(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 2 + sovBudget(uint64(m.Priority))
+	}
+	if len(m.Reserve) > 0 {
+		for _, e := range m.Reserve {
+			l = e.Size()
+			n += 2 + l + sovBudget(uint64(l))
+		}
+	}
+	if m.Paused {
+		n += 3
+	}
+	if m.Conditions != nil {
+		l = m.Conditions.Size()
+		n += 2 + l + sovBudget(uint64(l))
+	}
+	if m.EpochBlocks != 0 {
+		n += 2 + sovBudget(uint64(m.EpochBlocks))
+	}
+	if m.EpochOffset != 0 {
+		n += 2 + sovBudget(uint64(m.EpochOffset))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 2 + l + sovBudget(uint64(l))
+	}
+	l = len(m.Link)
+	if l > 0 {
+		n += 2 + l + sovBudget(uint64(l))
+	}
+	l = len(m.LinkHash)
+	if l > 0 {
+		n += 2 + l + sovBudget(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 2 + l + sovBudget(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 2 + l + sovBudget(uint64(l))
+		}
+	}
+	if m.ID != 0 {
+		n += 2 + sovBudget(uint64(m.ID))
+	}
+	return n
+}
+
+func (m *BudgetConditions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinSourceBalance) > 0 {
+		for _, e := range m.MinSourceBalance {
+			l = e.Size()
+			n += 1 + l + sovBudget(uint64(l))
+		}
+	}
+	if len(m.MaxDestinationBalance) > 0 {
+		for _, e := range m.MaxDestinationBalance {
+			l = e.Size()
+			n += 1 + l + sovBudget(uint64(l))
+		}
+	}
+	if m.MinBondedRatio != nil {
+		l = m.MinBondedRatio.Size()
+		n += 1 + l + sovBudget(uint64(l))
+	}
+	if m.MaxBondedRatio != nil {
+		l = m.MaxBondedRatio.Size()
+		n += 1 + l + sovBudget(uint64(l))
+	}
+	return n
+}
+
+func (m *Recurrence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovBudget(uint64(m.Type))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovBudget(uint64(l))
+	return n
+}
+
+func (m *RateSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovBudget(uint64(m.Type))
+	}
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovBudget(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovBudget(uint64(l))
+	return n
+}
+
+func (m *SchedulePoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovBudget(uint64(l))
+	l = m.Factor.Size()
+	n += 1 + l + sovBudget(uint64(l))
+	return n
+}
+
+func (m *BudgetDestination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBudget(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovBudget(uint64(l))
+	if m.Type != 0 {
+		n += 1 + sovBudget(uint64(m.Type))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovBudget(uint64(l))
+		}
+	}
+	l = len(m.RewardsAddress)
+	if l > 0 {
+		n += 1 + l + sovBudget(uint64(l))
+	}
+	if m.IBCTransfer != nil {
+		l = m.IBCTransfer.Size()
+		n += 1 + l + sovBudget(uint64(l))
+	}
+	return n
+}
+
+func (m *IBCTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourcePort)
+	if l > 0 {
+		n += 1 + l + sovBudget(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovBudget(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovBudget(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout)
+	n += 1 + l + sovBudget(uint64(l))
+	if m.OnFailure != 0 {
+		n += 1 + sovBudget(uint64(m.OnFailure))
+	}
+	return n
+}
+
+func (m *DenomRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovBudget(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovBudget(uint64(l))
+	return n
+}
+
+func (m *TotalCollectedCoins) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalCollectedCoins) > 0 {
+		for _, e := range m.TotalCollectedCoins {
+			l = e.Size()
+			n += 1 + l + sovBudget(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TotalBurnedCoins) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalBurnedCoins) > 0 {
+		for _, e := range m.TotalBurnedCoins {
+			l = e.Size()
+			n += 1 + l + sovBudget(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Remainder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Remainder) > 0 {
+		for _, e := range m.Remainder {
+			l = e.Size()
+			n += 1 + l + sovBudget(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ArchivedBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Budget.Size()
+	n += 1 + l + sovBudget(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovBudget(uint64(m.Status))
+	}
+	if m.ArchivedHeight != 0 {
+		n += 1 + sovBudget(uint64(m.ArchivedHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ArchivedTime)
+	n += 1 + l + sovBudget(uint64(l))
+	if len(m.TotalCollectedCoins) > 0 {
+		for _, e := range m.TotalCollectedCoins {
+			l = e.Size()
+			n += 1 + l + sovBudget(uint64(l))
+		}
+	}
+	if len(m.DestinationCollectedCoins) > 0 {
+		for _, e := range m.DestinationCollectedCoins {
+			l = e.Size()
+			n += 1 + l + sovBudget(uint64(l))
+		}
+	}
+	if m.Unbonding {
+		n += 2
+	}
+	return n
+}
+
+func (m *CollectionRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BudgetID != 0 {
+		n += 1 + sovBudget(uint64(m.BudgetID))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBudget(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovBudget(uint64(l))
+	if len(m.SourceBalances) > 0 {
+		for _, e := range m.SourceBalances {
+			l = e.Size()
+			n += 1 + l + sovBudget(uint64(l))
+		}
+	}
+	if len(m.CollectedCoins) > 0 {
+		for _, e := range m.CollectedCoins {
+			l = e.Size()
+			n += 1 + l + sovBudget(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DestinationCollectedCoins) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DestinationAddress)
+	if l > 0 {
+		n += 1 + l + sovBudget(uint64(l))
+	}
+	if len(m.TotalCollectedCoins) > 0 {
+		for _, e := range m.TotalCollectedCoins {
+			l = e.Size()
+			n += 1 + l + sovBudget(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovBudget(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBudget(x uint64) (n int) {
+	return sovBudget(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBudget
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBlocks", wireType)
+			}
+			m.EpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceProcessingModes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceProcessingModes = append(m.SourceProcessingModes, SourceProcessingMode{})
+			if err := m.SourceProcessingModes[len(m.SourceProcessingModes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceReserves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceReserves = append(m.SourceReserves, SourceReserve{})
+			if err := m.SourceReserves[len(m.SourceReserves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochMode", wireType)
+			}
+			m.EpochMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochMode |= EpochMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.EpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryRetentionBlocks", wireType)
+			}
+			m.HistoryRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBudget(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SourceReserve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBudget
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceReserve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceReserve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reserve = append(m.Reserve, types.Coin{})
+			if err := m.Reserve[len(m.Reserve)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBudget(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SourceProcessingMode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBudget
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SourceProcessingMode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SourceProcessingMode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= ProcessingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBudget(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Budget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBudget
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Budget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Budget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= BudgetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomRates = append(m.DenomRates, DenomRate{})
+			if err := m.DenomRates[len(m.DenomRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedDenoms = append(m.DeniedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LifetimeCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LifetimeCap = append(m.LifetimeCap, types.Coin{})
+			if err := m.LifetimeCap[len(m.LifetimeCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEpochAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxEpochAmount = append(m.MaxEpochAmount, types.Coin{})
+			if err := m.MaxEpochAmount[len(m.MaxEpochAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinEpochAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinEpochAmount = append(m.MinEpochAmount, types.Coin{})
+			if err := m.MinEpochAmount[len(m.MinEpochAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destinations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destinations = append(m.Destinations, BudgetDestination{})
+			if err := m.Destinations[len(m.Destinations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schedule == nil {
+				m.Schedule = &RateSchedule{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recurrence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Recurrence == nil {
+				m.Recurrence = &Recurrence{}
+			}
+			if err := m.Recurrence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reserve = append(m.Reserve, types.Coin{})
+			if err := m.Reserve[len(m.Reserve)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Conditions == nil {
+				m.Conditions = &BudgetConditions{}
+			}
+			if err := m.Conditions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochBlocks", wireType)
+			}
+			m.EpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochOffset", wireType)
+			}
+			m.EpochOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochOffset |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Link", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Link = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBudget(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BudgetConditions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBudget
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BudgetConditions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BudgetConditions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSourceBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinSourceBalance = append(m.MinSourceBalance, types.Coin{})
+			if err := m.MinSourceBalance[len(m.MinSourceBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDestinationBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxDestinationBalance = append(m.MaxDestinationBalance, types.Coin{})
+			if err := m.MaxDestinationBalance[len(m.MaxDestinationBalance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBondedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MinBondedRatio = &v
+			if err := m.MinBondedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBondedRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxBondedRatio = &v
+			if err := m.MaxBondedRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBudget(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Recurrence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBudget
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Recurrence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Recurrence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= RecurrenceType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBudget(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBudget
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ScheduleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = append(m.Points, SchedulePoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBudget(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulePoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBudget
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulePoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulePoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBudget(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BudgetDestination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBudget
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BudgetDestination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BudgetDestination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= DestinationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IBCTransfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IBCTransfer == nil {
+				m.IBCTransfer = &IBCTransfer{}
+			}
+			if err := m.IBCTransfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBudget(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBudget
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnFailure", wireType)
+			}
+			m.OnFailure = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OnFailure |= IBCFailureAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBudget(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBudget
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBudget(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalCollectedCoins) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBudget
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalCollectedCoins: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalCollectedCoins: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCollectedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalCollectedCoins = append(m.TotalCollectedCoins, types.Coin{})
+			if err := m.TotalCollectedCoins[len(m.TotalCollectedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBudget(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TotalBurnedCoins) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBudget
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TotalBurnedCoins: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TotalBurnedCoins: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurnedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalBurnedCoins = append(m.TotalBurnedCoins, types.Coin{})
+			if err := m.TotalBurnedCoins[len(m.TotalBurnedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBudget(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Remainder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBudget
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Remainder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Remainder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remainder = append(m.Remainder, types.DecCoin{})
+			if err := m.Remainder[len(m.Remainder)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBudget(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArchivedBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
 				return ErrIntOverflowBudget
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArchivedBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArchivedBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Budget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochBlocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.EpochBlocks = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBudget
@@ -493,14 +5916,100 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochBlocks |= uint32(b&0x7F) << shift
+				m.Status |= BudgetStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedHeight", wireType)
+			}
+			m.ArchivedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ArchivedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ArchivedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCollectedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalCollectedCoins = append(m.TotalCollectedCoins, types.Coin{})
+			if err := m.TotalCollectedCoins[len(m.TotalCollectedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Budgets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCollectedCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -527,11 +6036,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Budgets = append(m.Budgets, Budget{})
-			if err := m.Budgets[len(m.Budgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DestinationCollectedCoins = append(m.DestinationCollectedCoins, DestinationCollectedCoins{})
+			if err := m.DestinationCollectedCoins[len(m.DestinationCollectedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unbonding = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBudget(dAtA[iNdEx:])
@@ -553,7 +6082,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Budget) Unmarshal(dAtA []byte) error {
+func (m *CollectionRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -576,17 +6105,17 @@ func (m *Budget) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Budget: wiretype end group for non-group")
+			return fmt.Errorf("proto: CollectionRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Budget: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CollectionRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BudgetID", wireType)
 			}
-			var stringLen uint64
+			m.BudgetID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBudget
@@ -596,29 +6125,16 @@ func (m *Budget) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BudgetID |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBudget
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBudget
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBudget
@@ -628,31 +6144,16 @@ func (m *Budget) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBudget
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBudget
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBudget
@@ -662,59 +6163,28 @@ func (m *Budget) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBudget
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBudget
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBudget
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBudget
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBudget
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceBalances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -741,13 +6211,14 @@ func (m *Budget) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.SourceBalances = append(m.SourceBalances, types.Coin{})
+			if err := m.SourceBalances[len(m.SourceBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectedCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -774,7 +6245,8 @@ func (m *Budget) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.CollectedCoins = append(m.CollectedCoins, types.Coin{})
+			if err := m.CollectedCoins[len(m.CollectedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -799,7 +6271,7 @@ func (m *Budget) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TotalCollectedCoins) Unmarshal(dAtA []byte) error {
+func (m *DestinationCollectedCoins) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -822,13 +6294,45 @@ func (m *TotalCollectedCoins) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TotalCollectedCoins: wiretype end group for non-group")
+			return fmt.Errorf("proto: DestinationCollectedCoins: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TotalCollectedCoins: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DestinationCollectedCoins: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBudget
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBudget
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBudget
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TotalCollectedCoins", wireType)
 			}
@@ -0,0 +1,251 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Caps that can be applied to the collection of a budget.
+const (
+	CapMaxEpochAmount = "max_epoch_amount"
+	CapMinEpochAmount = "min_epoch_amount"
+	CapLifetime       = "lifetime_cap"
+)
+
+// BudgetCollection defines the coins that a budget collects from its source in an epoch.
+type BudgetCollection struct {
+	Budget Budget
+	// Coins is the amount of coins to be collected by the budget.
+	Coins sdk.Coins
+	// UncappedCoins is the amount of coins before the caps of the budget are applied.
+	UncappedCoins sdk.Coins
+	// Caps are the caps that changed the amount of coins to be collected.
+	Caps []string
+	// Exhausted is true if the lifetime cap of the budget is reached by the collection.
+	Exhausted bool
+	// DestinationCoins are the coins split for each destination of the budget, in the same
+	// order as the destinations of the budget.
+	DestinationCoins []sdk.Coins
+	// Remainder is the fractional remainder of the coins to be carried forward to the next
+	// collection of the budget.
+	Remainder sdk.DecCoins
+}
+
+// Collections returns the collections of the budgets from the given source balances.
+// totalCollectedCoins are the coins collected by each budget so far, in the same order as budgets.
+// Budgets of the rate type are calculated based on the same source balances, and budgets of the
+// fixed amount type are then served in order from the balances remaining after the rate budgets.
+// The maximum epoch amount and the lifetime cap of each budget limit its collection, and the minimum
+// epoch amount of a rate budget tops it up from the balances remaining after the rate budgets.
+// The fractional remainder of each rate budget is added to its collection, and the remainder left
+// by the truncation of the collection is carried forward unless the collection of the denom is limited.
+// The remainder of a denom that is not collected in the epoch is carried forward as it is.
+// A budget never collects more than what remains in the source above the reserve of the budget.
+func Collections(budgets []Budget, sourceBalances sdk.Coins, totalCollectedCoins []sdk.Coins, remainders []sdk.DecCoins) []BudgetCollection {
+	collections := make([]BudgetCollection, len(budgets))
+	remainingBalances := sourceBalances
+	for i, budget := range budgets {
+		collections[i].Budget = budget
+		if budget.Type != BudgetTypeRate {
+			continue
+		}
+		collections[i].collectRate(
+			sdk.NewDecCoinsFromCoins(CoinsAbove(sourceBalances, budget.Reserve)...),
+			remainingBalances, totalCollectedCoins[i], remainders[i])
+		remainingBalances = remainingBalances.Sub(collections[i].Coins)
+	}
+	for i, budget := range budgets {
+		if budget.Type != BudgetTypeRate {
+			continue
+		}
+		remainingBalances = collections[i].applyMinEpochAmount(remainingBalances, totalCollectedCoins[i])
+	}
+	for i, budget := range budgets {
+		if budget.Type != BudgetTypeFixedAmount {
+			continue
+		}
+		collections[i].Coins = MinCoins(budget.Amount, CoinsAbove(remainingBalances, budget.Reserve))
+		collections[i].applyMaxCaps(totalCollectedCoins[i])
+		remainingBalances = remainingBalances.Sub(collections[i].Coins)
+	}
+	finalizeCollections(collections, totalCollectedCoins)
+	return collections
+}
+
+// SequentialCollections returns the collections of the budgets from the given source balances, where
+// each budget takes from the balances remaining after the budgets before it, in the given order.
+// totalCollectedCoins are the coins collected by each budget so far, in the same order as budgets.
+// The rate of a budget of the rate type is applied to the remaining balances, and the caps of each
+// budget are applied before the next budget is served.
+func SequentialCollections(budgets []Budget, sourceBalances sdk.Coins, totalCollectedCoins []sdk.Coins, remainders []sdk.DecCoins) []BudgetCollection {
+	collections := make([]BudgetCollection, len(budgets))
+	remainingBalances := sourceBalances
+	for i, budget := range budgets {
+		collections[i].Budget = budget
+		switch budget.Type {
+		case BudgetTypeRate:
+			collections[i].collectRate(
+				sdk.NewDecCoinsFromCoins(CoinsAbove(remainingBalances, budget.Reserve)...),
+				remainingBalances, totalCollectedCoins[i], remainders[i])
+			remainingBalances = remainingBalances.Sub(collections[i].Coins)
+			remainingBalances = collections[i].applyMinEpochAmount(remainingBalances, totalCollectedCoins[i])
+		case BudgetTypeFixedAmount:
+			collections[i].Coins = MinCoins(budget.Amount, CoinsAbove(remainingBalances, budget.Reserve))
+			collections[i].applyMaxCaps(totalCollectedCoins[i])
+			remainingBalances = remainingBalances.Sub(collections[i].Coins)
+		}
+	}
+	finalizeCollections(collections, totalCollectedCoins)
+	return collections
+}
+
+// collectRate sets the coins that the rates of the budget of the collection take from the balances
+// along with the remainder of the budget, limited by the caps of the budget and the remaining balances
+// above the reserve of the budget.
+func (collection *BudgetCollection) collectRate(balances sdk.DecCoins, remainingBalances, totalCollectedCoins sdk.Coins, remainder sdk.DecCoins) {
+	var decCoins sdk.DecCoins
+	collected := make(map[string]bool)
+	for _, balance := range balances {
+		rate := collection.Budget.DenomRate(balance.Denom)
+		if rate.IsPositive() {
+			amount := balance.Amount.MulTruncate(rate).Add(remainder.AmountOf(balance.Denom))
+			decCoins = append(decCoins, sdk.NewDecCoinFromDec(balance.Denom, amount))
+			collected[balance.Denom] = true
+		}
+	}
+	coins, fraction := decCoins.TruncateDecimal()
+	collection.Coins = coins
+	collection.applyMaxCaps(totalCollectedCoins)
+	collection.Coins = MinCoins(collection.Coins, CoinsAbove(remainingBalances, collection.Budget.Reserve))
+
+	// The remainder of a denom is dropped if the collection of the denom is limited.
+	collection.Remainder = nil
+	for _, decCoin := range fraction {
+		if collection.Coins.AmountOf(decCoin.Denom).GTE(coins.AmountOf(decCoin.Denom)) {
+			collection.Remainder = append(collection.Remainder, decCoin)
+		}
+	}
+	// The remainder of a denom that is not collected in the epoch, for example because its balance is not
+	// above the reserve, is carried forward as it is.
+	for _, decCoin := range remainder {
+		if !collected[decCoin.Denom] {
+			collection.Remainder = collection.Remainder.Add(decCoin)
+		}
+	}
+}
+
+// finalizeCollections marks the collections that exhaust the lifetime cap of their budgets, and
+// splits the coins of each collection for the destinations of its budget.
+func finalizeCollections(collections []BudgetCollection, totalCollectedCoins []sdk.Coins) {
+	for i, collection := range collections {
+		budget := collection.Budget
+		if !budget.LifetimeCap.Empty() {
+			collections[i].Exhausted = totalCollectedCoins[i].Add(collection.Coins...).IsAllGTE(budget.LifetimeCap)
+		}
+		var weights []sdk.Dec
+		for _, destination := range budget.CollectionDestinations() {
+			weights = append(weights, destination.Weight)
+		}
+		collections[i].DestinationCoins = SplitCoins(collection.Coins, weights)
+	}
+}
+
+// SplitCoins splits the coins by the given relative weights. The share of each weight is truncated,
+// and the remainder left by the truncation is added to the first share.
+func SplitCoins(coins sdk.Coins, weights []sdk.Dec) []sdk.Coins {
+	shares := make([]sdk.Coins, len(weights))
+	if len(weights) == 0 {
+		return shares
+	}
+	totalWeight := sdk.ZeroDec()
+	for _, weight := range weights {
+		totalWeight = totalWeight.Add(weight)
+	}
+	decCoins := sdk.NewDecCoinsFromCoins(coins...)
+	remainder := coins
+	for i := len(weights) - 1; i > 0; i-- {
+		shares[i], _ = decCoins.MulDecTruncate(weights[i]).QuoDecTruncate(totalWeight).TruncateDecimal()
+		remainder = remainder.Sub(shares[i])
+	}
+	shares[0] = remainder
+	return shares
+}
+
+// applyMaxCaps limits the coins of the collection by the maximum epoch amount and the
+// remaining lifetime cap of the budget.
+func (collection *BudgetCollection) applyMaxCaps(totalCollectedCoins sdk.Coins) {
+	if collection.UncappedCoins == nil {
+		collection.UncappedCoins = collection.Coins
+	}
+	if !collection.Budget.MaxEpochAmount.Empty() {
+		collection.limit(collection.Budget.MaxEpochAmount, CapMaxEpochAmount)
+	}
+	if !collection.Budget.LifetimeCap.Empty() {
+		remainingCap := make(map[string]sdk.Int)
+		for _, coin := range collection.Budget.LifetimeCap {
+			remainingCap[coin.Denom] = sdk.MaxInt(coin.Amount.Sub(totalCollectedCoins.AmountOf(coin.Denom)), sdk.ZeroInt())
+		}
+		var limit sdk.Coins
+		for _, coin := range collection.Coins {
+			if amount, ok := remainingCap[coin.Denom]; ok && amount.LT(coin.Amount) {
+				limit = append(limit, sdk.Coin{Denom: coin.Denom, Amount: amount})
+			}
+		}
+		collection.limit(limit, CapLifetime)
+	}
+}
+
+// applyMinEpochAmount tops up the coins of the collection to the minimum epoch amount of the budget
+// from the remaining balances of the source, and returns the balances that remain after the top-up.
+func (collection *BudgetCollection) applyMinEpochAmount(remainingBalances, totalCollectedCoins sdk.Coins) sdk.Coins {
+	if collection.UncappedCoins == nil {
+		collection.UncappedCoins = collection.Coins
+	}
+	var shortfall sdk.Coins
+	for _, coin := range collection.Budget.MinEpochAmount {
+		if amount := coin.Amount.Sub(collection.Coins.AmountOf(coin.Denom)); amount.IsPositive() {
+			shortfall = append(shortfall, sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	topUp := MinCoins(shortfall, CoinsAbove(remainingBalances, collection.Budget.Reserve))
+	if topUp.Empty() {
+		return remainingBalances
+	}
+	coins := collection.Coins
+	collection.Coins = collection.Coins.Add(topUp...)
+	collection.addCap(CapMinEpochAmount)
+	collection.applyMaxCaps(totalCollectedCoins)
+	return remainingBalances.Sub(collection.Coins.Sub(coins))
+}
+
+// limit limits the amount of each denom of the coins of the collection to the amount of the
+// denom in the given limit. Denoms that do not exist in the limit are not limited.
+func (collection *BudgetCollection) limit(limit sdk.Coins, capName string) {
+	var coins sdk.Coins
+	limited := false
+	for _, coin := range collection.Coins {
+		for _, limitCoin := range limit {
+			if limitCoin.Denom == coin.Denom && limitCoin.Amount.LT(coin.Amount) {
+				coin = sdk.Coin{Denom: coin.Denom, Amount: limitCoin.Amount}
+				limited = true
+				break
+			}
+		}
+		if coin.Amount.IsPositive() {
+			coins = append(coins, coin)
+		}
+	}
+	if limited {
+		collection.Coins = coins
+		collection.addCap(capName)
+	}
+}
+
+// addCap adds the cap to the caps of the collection if it does not exist.
+func (collection *BudgetCollection) addCap(capName string) {
+	for _, c := range collection.Caps {
+		if c == capName {
+			return
+		}
+	}
+	collection.Caps = append(collection.Caps, capName)
+}
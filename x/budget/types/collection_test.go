@@ -0,0 +1,199 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/budget/x/budget/types"
+)
+
+func TestCollections(t *testing.T) {
+	fixedBudget := types.Budget{
+		Type:   types.BudgetTypeFixedAmount,
+		Amount: sdk.NewCoins(sdk.NewInt64Coin("denom1", 300), sdk.NewInt64Coin("denom2", 300)),
+	}
+	collections := types.Collections(
+		[]types.Budget{fixedBudget, {Rate: sdk.NewDecWithPrec(5, 1)}, fixedBudget},
+		sdk.NewCoins(sdk.NewInt64Coin("denom1", 1000), sdk.NewInt64Coin("denom2", 700)),
+		make([]sdk.Coins, 3),
+		make([]sdk.DecCoins, 3),
+	)
+	require.Len(t, collections, 3)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 300), sdk.NewInt64Coin("denom2", 300)), collections[0].Coins)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 500), sdk.NewInt64Coin("denom2", 350)), collections[1].Coins)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 200), sdk.NewInt64Coin("denom2", 50)), collections[2].Coins)
+	for _, collection := range collections {
+		require.Empty(t, collection.Caps)
+		require.False(t, collection.Exhausted)
+	}
+}
+
+func TestCollectionsCaps(t *testing.T) {
+	sourceBalances := sdk.NewCoins(sdk.NewInt64Coin("denom1", 1000), sdk.NewInt64Coin("denom2", 1000))
+
+	for _, tc := range []struct {
+		name                string
+		budget              types.Budget
+		totalCollectedCoins sdk.Coins
+		expectedCoins       sdk.Coins
+		expectedCaps        []string
+		expectedExhausted   bool
+	}{
+		{
+			"max epoch amount",
+			types.Budget{
+				Rate:           sdk.NewDecWithPrec(5, 1),
+				MaxEpochAmount: sdk.NewCoins(sdk.NewInt64Coin("denom1", 100)),
+			},
+			nil,
+			sdk.NewCoins(sdk.NewInt64Coin("denom1", 100), sdk.NewInt64Coin("denom2", 500)),
+			[]string{types.CapMaxEpochAmount},
+			false,
+		},
+		{
+			"min epoch amount",
+			types.Budget{
+				Rate:           sdk.NewDecWithPrec(1, 1),
+				MinEpochAmount: sdk.NewCoins(sdk.NewInt64Coin("denom2", 300)),
+			},
+			nil,
+			sdk.NewCoins(sdk.NewInt64Coin("denom1", 100), sdk.NewInt64Coin("denom2", 300)),
+			[]string{types.CapMinEpochAmount},
+			false,
+		},
+		{
+			"lifetime cap reached",
+			types.Budget{
+				Rate:        sdk.NewDecWithPrec(5, 1),
+				LifetimeCap: sdk.NewCoins(sdk.NewInt64Coin("denom1", 1000)),
+			},
+			sdk.NewCoins(sdk.NewInt64Coin("denom1", 800)),
+			sdk.NewCoins(sdk.NewInt64Coin("denom1", 200), sdk.NewInt64Coin("denom2", 500)),
+			[]string{types.CapLifetime},
+			true,
+		},
+		{
+			"lifetime cap not reached",
+			types.Budget{
+				Rate:        sdk.NewDecWithPrec(5, 1),
+				LifetimeCap: sdk.NewCoins(sdk.NewInt64Coin("denom1", 1000)),
+			},
+			nil,
+			sdk.NewCoins(sdk.NewInt64Coin("denom1", 500), sdk.NewInt64Coin("denom2", 500)),
+			nil,
+			false,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			collections := types.Collections([]types.Budget{tc.budget}, sourceBalances, []sdk.Coins{tc.totalCollectedCoins}, make([]sdk.DecCoins, 1))
+			require.Len(t, collections, 1)
+			require.Equal(t, tc.expectedCoins, collections[0].Coins)
+			require.Equal(t, tc.expectedCaps, collections[0].Caps)
+			require.Equal(t, tc.expectedExhausted, collections[0].Exhausted)
+		})
+	}
+}
+
+func TestSplitCoins(t *testing.T) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin("denom1", 1000), sdk.NewInt64Coin("denom2", 7))
+	shares := types.SplitCoins(coins, []sdk.Dec{sdk.NewDec(1), sdk.NewDec(1), sdk.NewDec(1)})
+	require.Len(t, shares, 3)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 334), sdk.NewInt64Coin("denom2", 3)), shares[0])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 333), sdk.NewInt64Coin("denom2", 2)), shares[1])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 333), sdk.NewInt64Coin("denom2", 2)), shares[2])
+
+	shares = types.SplitCoins(coins, []sdk.Dec{sdk.OneDec()})
+	require.Equal(t, []sdk.Coins{coins}, shares)
+
+	shares = types.SplitCoins(coins, []sdk.Dec{sdk.NewDecWithPrec(7, 1), sdk.NewDecWithPrec(3, 1)})
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 700), sdk.NewInt64Coin("denom2", 5)), shares[0])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 300), sdk.NewInt64Coin("denom2", 2)), shares[1])
+}
+
+func TestSequentialCollections(t *testing.T) {
+	budgets := []types.Budget{
+		{Rate: sdk.NewDecWithPrec(5, 1)},
+		{Type: types.BudgetTypeFixedAmount, Amount: sdk.NewCoins(sdk.NewInt64Coin("denom1", 300))},
+		{Rate: sdk.NewDecWithPrec(5, 1)},
+	}
+	sourceBalances := sdk.NewCoins(sdk.NewInt64Coin("denom1", 1000), sdk.NewInt64Coin("denom2", 1000))
+
+	collections := types.SequentialCollections(budgets, sourceBalances, make([]sdk.Coins, 3), make([]sdk.DecCoins, 3))
+	require.Len(t, collections, 3)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 500), sdk.NewInt64Coin("denom2", 500)), collections[0].Coins)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 300)), collections[1].Coins)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 100), sdk.NewInt64Coin("denom2", 250)), collections[2].Coins)
+
+	// the same budgets calculated from the same source balances
+	collections = types.Collections(budgets, sourceBalances, make([]sdk.Coins, 3), make([]sdk.DecCoins, 3))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 500), sdk.NewInt64Coin("denom2", 500)), collections[0].Coins)
+	require.True(t, collections[1].Coins.Empty())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 500), sdk.NewInt64Coin("denom2", 500)), collections[2].Coins)
+}
+
+func TestCollectionsRemainder(t *testing.T) {
+	budget := types.Budget{Rate: sdk.NewDecWithPrec(1, 1)}
+	sourceBalances := sdk.NewCoins(sdk.NewInt64Coin("denom1", 15), sdk.NewInt64Coin("denom2", 25))
+	remainder := sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom1", sdk.NewDecWithPrec(7, 1)))
+
+	collections := types.Collections([]types.Budget{budget}, sourceBalances, make([]sdk.Coins, 1), []sdk.DecCoins{remainder})
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 2), sdk.NewInt64Coin("denom2", 2)), collections[0].Coins)
+	require.Equal(t, sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("denom1", sdk.NewDecWithPrec(2, 1)),
+		sdk.NewDecCoinFromDec("denom2", sdk.NewDecWithPrec(5, 1)),
+	), collections[0].Remainder)
+
+	// the remainder of a capped denom is dropped
+	budget.MaxEpochAmount = sdk.NewCoins(sdk.NewInt64Coin("denom1", 1))
+	collections = types.SequentialCollections([]types.Budget{budget}, sourceBalances, make([]sdk.Coins, 1), []sdk.DecCoins{remainder})
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 1), sdk.NewInt64Coin("denom2", 2)), collections[0].Coins)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom2", sdk.NewDecWithPrec(5, 1))), collections[0].Remainder)
+
+	// the remainder of a denom whose balance drops out for an epoch is carried forward until it comes back
+	budget.MaxEpochAmount = nil
+	remainder = sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("denom1", sdk.NewDecWithPrec(7, 1)),
+		sdk.NewDecCoinFromDec("denom2", sdk.NewDecWithPrec(5, 1)),
+	)
+	collections = types.Collections([]types.Budget{budget}, sdk.NewCoins(sdk.NewInt64Coin("denom1", 15)), make([]sdk.Coins, 1), []sdk.DecCoins{remainder})
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 2)), collections[0].Coins)
+	require.Equal(t, sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("denom1", sdk.NewDecWithPrec(2, 1)),
+		sdk.NewDecCoinFromDec("denom2", sdk.NewDecWithPrec(5, 1)),
+	), collections[0].Remainder)
+	collections = types.Collections([]types.Budget{budget}, sourceBalances, make([]sdk.Coins, 1), []sdk.DecCoins{collections[0].Remainder})
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 1), sdk.NewInt64Coin("denom2", 3)), collections[0].Coins)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("denom1", sdk.NewDecWithPrec(7, 1))), collections[0].Remainder)
+
+	// the remainder of a denom at or below the reserve is carried forward as well
+	budget.Reserve = sdk.NewCoins(sdk.NewInt64Coin("denom2", 25))
+	collections = types.SequentialCollections([]types.Budget{budget}, sourceBalances, make([]sdk.Coins, 1), []sdk.DecCoins{remainder})
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 2)), collections[0].Coins)
+	require.Equal(t, remainder.AmountOf("denom2"), collections[0].Remainder.AmountOf("denom2"))
+}
+
+func TestCollectionsReserve(t *testing.T) {
+	budgets := []types.Budget{
+		{Rate: sdk.NewDecWithPrec(5, 1), Reserve: sdk.NewCoins(sdk.NewInt64Coin("denom1", 400))},
+		{
+			Type:    types.BudgetTypeFixedAmount,
+			Amount:  sdk.NewCoins(sdk.NewInt64Coin("denom1", 300)),
+			Reserve: sdk.NewCoins(sdk.NewInt64Coin("denom1", 500)),
+		},
+		{Type: types.BudgetTypeFixedAmount, Amount: sdk.NewCoins(sdk.NewInt64Coin("denom2", 600))},
+	}
+	sourceBalances := sdk.NewCoins(sdk.NewInt64Coin("denom1", 1000), sdk.NewInt64Coin("denom2", 1000))
+
+	for _, collections := range [][]types.BudgetCollection{
+		types.Collections(budgets, sourceBalances, make([]sdk.Coins, 3), make([]sdk.DecCoins, 3)),
+		types.SequentialCollections(budgets, sourceBalances, make([]sdk.Coins, 3), make([]sdk.DecCoins, 3)),
+	} {
+		// the rate applies to the balance above the reserve only
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 300), sdk.NewInt64Coin("denom2", 500)), collections[0].Coins)
+		// the fixed amount is limited to what remains above the reserve
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 200)), collections[1].Coins)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom2", 500)), collections[2].Coins)
+	}
+}
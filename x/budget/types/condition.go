@@ -0,0 +1,89 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Conditions that can gate the collection of a budget.
+const (
+	ConditionMinSourceBalance      = "min_source_balance"
+	ConditionMaxDestinationBalance = "max_destination_balance"
+	ConditionMinBondedRatio        = "min_bonded_ratio"
+	ConditionMaxBondedRatio        = "max_bonded_ratio"
+	// ConditionResolvableAddresses is not set by budgets, and fails if an address of the budget cannot be resolved.
+	ConditionResolvableAddresses = "resolvable_addresses"
+)
+
+// Validate validates the conditions.
+func (conditions BudgetConditions) Validate() error {
+	if err := conditions.MinSourceBalance.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidConditions, "invalid %s: %v", ConditionMinSourceBalance, err)
+	}
+	if err := conditions.MaxDestinationBalance.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidConditions, "invalid %s: %v", ConditionMaxDestinationBalance, err)
+	}
+	for _, ratio := range []struct {
+		name  string
+		value *sdk.Dec
+	}{
+		{ConditionMinBondedRatio, conditions.MinBondedRatio},
+		{ConditionMaxBondedRatio, conditions.MaxBondedRatio},
+	} {
+		if isSetDec(ratio.value) && (ratio.value.IsNegative() || ratio.value.GT(sdk.OneDec())) {
+			return sdkerrors.Wrapf(ErrInvalidConditions, "%s must be between 0 and 1: %s", ratio.name, ratio.value)
+		}
+	}
+	if conditions.HasMinBondedRatio() && conditions.HasMaxBondedRatio() &&
+		conditions.MinBondedRatio.GT(*conditions.MaxBondedRatio) {
+		return sdkerrors.Wrapf(ErrInvalidConditions, "%s must not exceed %s", ConditionMinBondedRatio, ConditionMaxBondedRatio)
+	}
+	return nil
+}
+
+// HasMinBondedRatio returns whether the minimum bonded ratio condition is set.
+func (conditions BudgetConditions) HasMinBondedRatio() bool {
+	return isSetDec(conditions.MinBondedRatio)
+}
+
+// HasMaxBondedRatio returns whether the maximum bonded ratio condition is set. A maximum bonded ratio
+// of zero is set, and holds only when nothing is bonded.
+func (conditions BudgetConditions) HasMaxBondedRatio() bool {
+	return isSetDec(conditions.MaxBondedRatio)
+}
+
+// isSetDec returns whether the optional dec is set.
+func isSetDec(dec *sdk.Dec) bool {
+	return dec != nil && !dec.IsNil()
+}
+
+// MinSourceBalanceHolds returns whether the source balances have at least the minimum source balance.
+func (conditions BudgetConditions) MinSourceBalanceHolds(sourceBalances sdk.Coins) bool {
+	for _, coin := range conditions.MinSourceBalance {
+		if sourceBalances.AmountOf(coin.Denom).LT(coin.Amount) {
+			return false
+		}
+	}
+	return true
+}
+
+// MaxDestinationBalanceHolds returns whether the destination balances do not exceed the maximum
+// destination balance.
+func (conditions BudgetConditions) MaxDestinationBalanceHolds(destinationBalances sdk.Coins) bool {
+	for _, coin := range conditions.MaxDestinationBalance {
+		if destinationBalances.AmountOf(coin.Denom).GT(coin.Amount) {
+			return false
+		}
+	}
+	return true
+}
+
+// MinBondedRatioHolds returns whether the bonded ratio reaches the minimum bonded ratio.
+func (conditions BudgetConditions) MinBondedRatioHolds(bondedRatio sdk.Dec) bool {
+	return !conditions.HasMinBondedRatio() || bondedRatio.GTE(*conditions.MinBondedRatio)
+}
+
+// MaxBondedRatioHolds returns whether the bonded ratio does not exceed the maximum bonded ratio.
+func (conditions BudgetConditions) MaxBondedRatioHolds(bondedRatio sdk.Dec) bool {
+	return !conditions.HasMaxBondedRatio() || bondedRatio.LTE(*conditions.MaxBondedRatio)
+}
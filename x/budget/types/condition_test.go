@@ -0,0 +1,97 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/budget/x/budget/types"
+)
+
+func TestBudgetConditionsValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		conditions  types.BudgetConditions
+		expectedErr error
+	}{
+		{
+			"valid conditions",
+			types.BudgetConditions{
+				MinSourceBalance:      sdk.NewCoins(sdk.NewInt64Coin("denom1", 100)),
+				MaxDestinationBalance: sdk.NewCoins(sdk.NewInt64Coin("denom1", 100)),
+				MinBondedRatio:        decPtr(sdk.NewDecWithPrec(5, 1)),
+				MaxBondedRatio:        decPtr(sdk.NewDecWithPrec(7, 1)),
+			},
+			nil,
+		},
+		{
+			"empty conditions",
+			types.BudgetConditions{},
+			nil,
+		},
+		{
+			"invalid min source balance",
+			types.BudgetConditions{MinSourceBalance: sdk.Coins{sdk.Coin{Denom: "denom1", Amount: sdk.ZeroInt()}}},
+			types.ErrInvalidConditions,
+		},
+		{
+			"bonded ratio over 1",
+			types.BudgetConditions{MaxBondedRatio: decPtr(sdk.NewDecWithPrec(11, 1))},
+			types.ErrInvalidConditions,
+		},
+		{
+			"negative bonded ratio",
+			types.BudgetConditions{MinBondedRatio: decPtr(sdk.NewDecWithPrec(-1, 1))},
+			types.ErrInvalidConditions,
+		},
+		{
+			"zero max bonded ratio",
+			types.BudgetConditions{MinBondedRatio: decPtr(sdk.ZeroDec()), MaxBondedRatio: decPtr(sdk.ZeroDec())},
+			nil,
+		},
+		{
+			"min bonded ratio over max bonded ratio",
+			types.BudgetConditions{MinBondedRatio: decPtr(sdk.NewDecWithPrec(7, 1)), MaxBondedRatio: decPtr(sdk.NewDecWithPrec(5, 1))},
+			types.ErrInvalidConditions,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.conditions.Validate()
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestBudgetConditionsHold(t *testing.T) {
+	conditions := types.BudgetConditions{
+		MinSourceBalance:      sdk.NewCoins(sdk.NewInt64Coin("denom1", 100)),
+		MaxDestinationBalance: sdk.NewCoins(sdk.NewInt64Coin("denom1", 100)),
+		MinBondedRatio:        decPtr(sdk.NewDecWithPrec(5, 1)),
+	}
+	balances := sdk.NewCoins(sdk.NewInt64Coin("denom1", 100))
+	require.True(t, conditions.MinSourceBalanceHolds(balances))
+	require.True(t, conditions.MaxDestinationBalanceHolds(balances))
+	require.False(t, conditions.MinSourceBalanceHolds(sdk.NewCoins(sdk.NewInt64Coin("denom2", 100))))
+	require.False(t, conditions.MaxDestinationBalanceHolds(balances.Add(sdk.NewInt64Coin("denom1", 1))))
+
+	require.True(t, conditions.MinBondedRatioHolds(sdk.NewDecWithPrec(5, 1)))
+	require.False(t, conditions.MinBondedRatioHolds(sdk.NewDecWithPrec(4, 1)))
+	// an unset max bonded ratio always holds
+	require.False(t, conditions.HasMaxBondedRatio())
+	require.True(t, conditions.MaxBondedRatioHolds(sdk.OneDec()))
+
+	// a max bonded ratio of zero holds only when nothing is bonded
+	conditions.MaxBondedRatio = decPtr(sdk.ZeroDec())
+	require.True(t, conditions.HasMaxBondedRatio())
+	require.True(t, conditions.MaxBondedRatioHolds(sdk.ZeroDec()))
+	require.False(t, conditions.MaxBondedRatioHolds(sdk.NewDecWithPrec(1, 18)))
+}
+
+func decPtr(dec sdk.Dec) *sdk.Dec {
+	return &dec
+}
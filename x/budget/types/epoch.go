@@ -0,0 +1,19 @@
+package types
+
+import (
+	"time"
+)
+
+// EpochBoundary returns the latest epoch boundary at or before the block time in the duration epoch mode, given the
+// last epoch boundary, and whether the block starts a new epoch. The boundaries are anchored to the first block, and
+// the epochs that passed without any block are not collected later.
+func EpochBoundary(lastEpochTime, blockTime time.Time, epochDuration time.Duration) (time.Time, bool) {
+	if lastEpochTime.IsZero() {
+		return blockTime, true
+	}
+	if blockTime.Before(lastEpochTime.Add(epochDuration)) {
+		return lastEpochTime, false
+	}
+	epochs := blockTime.Sub(lastEpochTime) / epochDuration
+	return lastEpochTime.Add(epochs * epochDuration), true
+}
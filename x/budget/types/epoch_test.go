@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/budget/x/budget/types"
+)
+
+func TestEpochBoundary(t *testing.T) {
+	lastEpochTime := types.MustParseRFC3339("2021-08-01T00:00:00Z")
+	for _, tc := range []struct {
+		name          string
+		lastEpochTime time.Time
+		blockTime     time.Time
+		expected      time.Time
+		isEpoch       bool
+	}{
+		{
+			"first block",
+			time.Time{},
+			types.MustParseRFC3339("2021-08-01T00:00:05Z"),
+			types.MustParseRFC3339("2021-08-01T00:00:05Z"),
+			true,
+		},
+		{
+			"before the next boundary",
+			lastEpochTime,
+			types.MustParseRFC3339("2021-08-01T23:59:59Z"),
+			lastEpochTime,
+			false,
+		},
+		{
+			"at the next boundary",
+			lastEpochTime,
+			types.MustParseRFC3339("2021-08-02T00:00:00Z"),
+			types.MustParseRFC3339("2021-08-02T00:00:00Z"),
+			true,
+		},
+		{
+			"after the next boundary",
+			lastEpochTime,
+			types.MustParseRFC3339("2021-08-02T00:00:07Z"),
+			types.MustParseRFC3339("2021-08-02T00:00:00Z"),
+			true,
+		},
+		{
+			"after several boundaries",
+			lastEpochTime,
+			types.MustParseRFC3339("2021-08-05T12:00:00Z"),
+			types.MustParseRFC3339("2021-08-05T00:00:00Z"),
+			true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			epochTime, isEpoch := types.EpochBoundary(tc.lastEpochTime, tc.blockTime, 24*time.Hour)
+			require.True(t, tc.expected.Equal(epochTime))
+			require.Equal(t, tc.isEpoch, isEpoch)
+		})
+	}
+}
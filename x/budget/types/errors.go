@@ -11,4 +11,21 @@ var (
 	ErrInvalidBudgetRate      = sdkerrors.Register(ModuleName, 4, "invalid budget rate")
 	ErrInvalidTotalBudgetRate = sdkerrors.Register(ModuleName, 5, "invalid total rate of the budgets with the same source address")
 	ErrDuplicateBudgetName    = sdkerrors.Register(ModuleName, 6, "duplicate budget name")
+	ErrInvalidBudgetType      = sdkerrors.Register(ModuleName, 7, "invalid budget type")
+	ErrInvalidBudgetAmount    = sdkerrors.Register(ModuleName, 8, "invalid budget amount")
+	ErrInvalidBudgetDenoms    = sdkerrors.Register(ModuleName, 9, "invalid budget denoms")
+	ErrInvalidBudgetCap       = sdkerrors.Register(ModuleName, 10, "invalid budget cap")
+
+	ErrInvalidBudgetDestinations = sdkerrors.Register(ModuleName, 11, "invalid budget destinations")
+	ErrInvalidRateSchedule       = sdkerrors.Register(ModuleName, 12, "invalid rate schedule")
+	ErrInvalidStartEndHeight     = sdkerrors.Register(ModuleName, 13, "budget end height must be after the start height")
+	ErrInvalidRecurrence         = sdkerrors.Register(ModuleName, 14, "invalid budget recurrence")
+	ErrInvalidProcessingMode     = sdkerrors.Register(ModuleName, 15, "invalid source processing mode")
+	ErrInvalidReserve            = sdkerrors.Register(ModuleName, 16, "invalid budget reserve")
+	ErrInvalidConditions         = sdkerrors.Register(ModuleName, 17, "invalid budget conditions")
+	ErrInvalidEpoch              = sdkerrors.Register(ModuleName, 18, "invalid budget epoch")
+	ErrInvalidBudgetMetadata     = sdkerrors.Register(ModuleName, 19, "invalid budget metadata")
+	ErrInvalidBudgetID           = sdkerrors.Register(ModuleName, 20, "invalid budget id")
+	ErrBudgetNotFound            = sdkerrors.Register(ModuleName, 21, "budget not found")
+	ErrInvalidBudgetStatus       = sdkerrors.Register(ModuleName, 22, "invalid budget status")
 )
@@ -3,10 +3,45 @@ package types
 // Event types for the budget module.
 const (
 	EventTypeBudgetCollected = "budget_collected"
+	EventTypeBudgetCapped    = "budget_capped"
+	EventTypeBudgetExhausted = "budget_exhausted"
+	EventTypeBudgetSkipped   = "budget_skipped"
+	EventTypeBudgetDelegated = "budget_delegated"
+	EventTypeBudgetStarted   = "budget_started"
+	EventTypeBudgetEnded     = "budget_ended"
+	EventTypeBudgetUnbonded  = "budget_unbonded"
+
+	EventTypeBudgetIBCTransferSent         = "budget_ibc_transfer_sent"
+	EventTypeBudgetIBCTransferAcknowledged = "budget_ibc_transfer_acknowledged"
+	EventTypeBudgetIBCTransferRefunded     = "budget_ibc_transfer_refunded"
+	EventTypeBudgetIBCTransferParked       = "budget_ibc_transfer_parked"
 
 	AttributeValueName               = "name"
+	AttributeValueType               = "type"
 	AttributeValueDestinationAddress = "destination_address"
+	AttributeValueDestinationType    = "destination_type"
 	AttributeValueSourceAddress      = "source_address"
 	AttributeValueRate               = "rate"
 	AttributeValueAmount             = "amount"
+	AttributeValueOriginalAmount     = "original_amount"
+	AttributeValueCap                = "cap"
+	AttributeValueTotalCollected     = "total_collected_coins"
+	AttributeValueReserve            = "reserve"
+	AttributeValueCondition          = "condition"
+	AttributeValueValidator          = "validator"
+	AttributeValueSourceChannel      = "source_channel"
+	AttributeValueReceiver           = "receiver"
+	AttributeValueReason             = "reason"
+	AttributeValueStatus             = "status"
+)
+
+// Reasons of the IBC transfers that are refunded or parked.
+const (
+	IBCFailureReasonSendFailed = "send_failed"
+	IBCFailureReasonError      = "acknowledgement_error"
+	IBCFailureReasonTimeout    = "timeout"
+
+	// The balance of the IBC escrow account is refunded when the budget is archived or loses its IBC transfer destination.
+	IBCRefundReasonArchived           = "archived"
+	IBCRefundReasonDestinationRemoved = "destination_removed"
 )
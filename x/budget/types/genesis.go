@@ -1,13 +1,16 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // NewGenesisState returns new GenesisState instance.
-func NewGenesisState(params Params, records []BudgetRecord) *GenesisState {
+func NewGenesisState(params Params, budgets []Budget, lastBudgetID uint64, records []BudgetRecord) *GenesisState {
 	return &GenesisState{
 		Params:        params,
+		Budgets:       budgets,
+		LastBudgetID:  lastBudgetID,
 		BudgetRecords: records,
 	}
 }
@@ -16,6 +19,8 @@ func NewGenesisState(params Params, records []BudgetRecord) *GenesisState {
 func DefaultGenesisState() *GenesisState {
 	return NewGenesisState(
 		DefaultParams(),
+		[]Budget{},
+		0,
 		[]BudgetRecord{},
 	)
 }
@@ -25,15 +30,99 @@ func ValidateGenesis(data GenesisState) error {
 	if err := data.Params.Validate(); err != nil {
 		return err
 	}
+	if err := data.TotalBurnedCoins.Validate(); err != nil {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidCoins,
+			"invalid total burned coins %s: %v", data.TotalBurnedCoins, err)
+	}
+	if err := ValidateBudgets(data.Budgets); err != nil {
+		return err
+	}
+	ids := make(map[uint64]bool)
+	for _, budget := range data.Budgets {
+		if budget.ID == 0 || budget.ID > data.LastBudgetID {
+			return sdkerrors.Wrapf(ErrInvalidBudgetID, "budget %s must have an id from 1 to the last budget id %d", budget.Name, data.LastBudgetID)
+		}
+		if ids[budget.ID] {
+			return sdkerrors.Wrapf(ErrInvalidBudgetID, "duplicate budget id %d", budget.ID)
+		}
+		ids[budget.ID] = true
+	}
+	// The records of the budgets that have expired or have been removed are moved to their archived budgets.
 	for _, record := range data.BudgetRecords {
 		if err := record.TotalCollectedCoins.Validate(); err != nil {
 			return sdkerrors.Wrapf(
 				sdkerrors.ErrInvalidCoins,
 				"invalid total collected coins %s: %v", record.TotalCollectedCoins, err)
 		}
-		if err := ValidateName(record.Name); err != nil {
-			return err
+		if record.BudgetID == 0 || record.BudgetID > data.LastBudgetID {
+			return sdkerrors.Wrapf(ErrInvalidBudgetID, "record must have a budget id from 1 to the last budget id %d", data.LastBudgetID)
+		}
+		if record.LastCollectedHeight < 0 {
+			return sdkerrors.Wrapf(ErrInvalidEpoch, "invalid last collected height %d", record.LastCollectedHeight)
+		}
+		if err := record.Remainder.Validate(); err != nil {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidCoins,
+				"invalid remainder %s: %v", record.Remainder, err)
+		}
+		for _, destinationRecord := range record.DestinationCollectedCoins {
+			if _, err := sdk.AccAddressFromBech32(destinationRecord.DestinationAddress); err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid destination address %s: %v", destinationRecord.DestinationAddress, err)
+			}
+			if err := destinationRecord.TotalCollectedCoins.Validate(); err != nil {
+				return sdkerrors.Wrapf(
+					sdkerrors.ErrInvalidCoins,
+					"invalid total collected coins %s: %v", destinationRecord.TotalCollectedCoins, err)
+			}
+		}
+		if !ids[record.BudgetID] {
+			return sdkerrors.Wrapf(ErrBudgetNotFound, "budget %d of record not found", record.BudgetID)
+		}
+		if record.Status != BudgetStatusUnspecified && record.Status != BudgetStatusUpcoming && record.Status != BudgetStatusActive {
+			return sdkerrors.Wrapf(ErrInvalidBudgetStatus, "invalid status %s of budget %d", record.Status, record.BudgetID)
+		}
+	}
+	for _, archived := range data.ArchivedBudgets {
+		if archived.Budget.ID == 0 || archived.Budget.ID > data.LastBudgetID {
+			return sdkerrors.Wrapf(ErrInvalidBudgetID, "archived budget %s must have an id from 1 to the last budget id %d", archived.Budget.Name, data.LastBudgetID)
+		}
+		if ids[archived.Budget.ID] {
+			return sdkerrors.Wrapf(ErrInvalidBudgetID, "duplicate budget id %d", archived.Budget.ID)
+		}
+		if archived.Status != BudgetStatusExpired && archived.Status != BudgetStatusRemoved {
+			return sdkerrors.Wrapf(ErrInvalidBudgetStatus, "invalid status %s of archived budget %d", archived.Status, archived.Budget.ID)
+		}
+		if err := archived.TotalCollectedCoins.Validate(); err != nil {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidCoins,
+				"invalid total collected coins %s: %v", archived.TotalCollectedCoins, err)
+		}
+		ids[archived.Budget.ID] = true
+	}
+	recordKeys := make(map[string]bool)
+	for _, record := range data.CollectionRecords {
+		if record.BudgetID == 0 || record.BudgetID > data.LastBudgetID {
+			return sdkerrors.Wrapf(ErrInvalidBudgetID, "collection record must have a budget id from 1 to the last budget id %d", data.LastBudgetID)
+		}
+		if record.Height < 0 {
+			return sdkerrors.Wrapf(ErrInvalidEpoch, "invalid collection record height %d", record.Height)
+		}
+		key := string(GetCollectionRecordKey(record.BudgetID, record.Height))
+		if recordKeys[key] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate collection record of budget id %d at height %d", record.BudgetID, record.Height)
+		}
+		if err := record.SourceBalances.Validate(); err != nil {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidCoins,
+				"invalid source balances %s: %v", record.SourceBalances, err)
+		}
+		if err := record.CollectedCoins.Validate(); err != nil {
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidCoins,
+				"invalid collected coins %s: %v", record.CollectedCoins, err)
 		}
+		recordKeys[key] = true
 	}
 	return nil
 }
@@ -10,16 +10,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -33,6 +36,18 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// budget_records defines the budget records used for genesis state
 	BudgetRecords []BudgetRecord `protobuf:"bytes,2,rep,name=budget_records,json=budgetRecords,proto3" json:"budget_records" yaml:"budget_records"`
+	// total_burned_coins specifies the total coins burned by all budgets
+	TotalBurnedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_burned_coins,json=totalBurnedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_burned_coins" yaml:"total_burned_coins"`
+	// last_epoch_time specifies the last epoch boundary of the duration epoch mode, unset if zero
+	LastEpochTime time.Time `protobuf:"bytes,4,opt,name=last_epoch_time,json=lastEpochTime,proto3,stdtime" json:"last_epoch_time" yaml:"last_epoch_time"`
+	// budgets defines the budgets with their ids
+	Budgets []Budget `protobuf:"bytes,5,rep,name=budgets,proto3" json:"budgets" yaml:"budgets"`
+	// last_budget_id specifies the last id assigned to a budget
+	LastBudgetID uint64 `protobuf:"varint,6,opt,name=last_budget_id,json=lastBudgetId,proto3" json:"last_budget_id,omitempty" yaml:"last_budget_id"`
+	// collection_records defines the collection records of the budgets within the history retention
+	CollectionRecords []CollectionRecord `protobuf:"bytes,7,rep,name=collection_records,json=collectionRecords,proto3" json:"collection_records" yaml:"collection_records"`
+	// archived_budgets defines the budgets that have expired or have been removed, with their records
+	ArchivedBudgets []ArchivedBudget `protobuf:"bytes,8,rep,name=archived_budgets,json=archivedBudgets,proto3" json:"archived_budgets" yaml:"archived_budgets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,10 +85,23 @@ var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
 // BudgetRecord records the state of each budget after genesis import or export.
 type BudgetRecord struct {
-	// name defines the name of the budget
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
 	// total_collected_coins specifies the total collected coins in a budget ever since the budget is created
 	TotalCollectedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_collected_coins,json=totalCollectedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_collected_coins" yaml:"total_collected_coins"`
+	// destination_collected_coins specifies the total collected coins for each destination of the budget
+	DestinationCollectedCoins []DestinationCollectedCoins `protobuf:"bytes,3,rep,name=destination_collected_coins,json=destinationCollectedCoins,proto3" json:"destination_collected_coins" yaml:"destination_collected_coins"`
+	// next_period specifies the next recurrence period of the budget, the periods before it have been collected
+	NextPeriod uint64 `protobuf:"varint,4,opt,name=next_period,json=nextPeriod,proto3" json:"next_period,omitempty" yaml:"next_period"`
+	// remainder specifies the fractional remainder of the coins of the budget carried forward to the next collection
+	Remainder github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,5,rep,name=remainder,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"remainder" yaml:"remainder"`
+	// last_collected_height specifies the block height at which the budget last collected, unset if zero
+	LastCollectedHeight int64 `protobuf:"varint,6,opt,name=last_collected_height,json=lastCollectedHeight,proto3" json:"last_collected_height,omitempty" yaml:"last_collected_height"`
+	// budget_id defines the id of the budget
+	BudgetID uint64 `protobuf:"varint,7,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty" yaml:"budget_id"`
+	// status specifies the last updated lifecycle status of the budget
+	Status BudgetStatus `protobuf:"varint,8,opt,name=status,proto3,enum=cosmos.budget.v1beta1.BudgetStatus" json:"status,omitempty" yaml:"status"`
+	// unbonding specifies whether the delegations of the staking destination that the budget no longer has are
+	// unbonding, and the coins of its delegator account are returned to its source once they are unbonded
+	Unbonding bool `protobuf:"varint,9,opt,name=unbonding,proto3" json:"unbonding,omitempty" yaml:"unbonding"`
 }
 
 func (m *BudgetRecord) Reset()         { *m = BudgetRecord{} }
@@ -109,20 +137,62 @@ func (m *BudgetRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BudgetRecord proto.InternalMessageInfo
 
-func (m *BudgetRecord) GetName() string {
+func (m *BudgetRecord) GetTotalCollectedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Name
+		return m.TotalCollectedCoins
 	}
-	return ""
+	return nil
 }
 
-func (m *BudgetRecord) GetTotalCollectedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *BudgetRecord) GetDestinationCollectedCoins() []DestinationCollectedCoins {
 	if m != nil {
-		return m.TotalCollectedCoins
+		return m.DestinationCollectedCoins
+	}
+	return nil
+}
+
+func (m *BudgetRecord) GetNextPeriod() uint64 {
+	if m != nil {
+		return m.NextPeriod
+	}
+	return 0
+}
+
+func (m *BudgetRecord) GetRemainder() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Remainder
 	}
 	return nil
 }
 
+func (m *BudgetRecord) GetLastCollectedHeight() int64 {
+	if m != nil {
+		return m.LastCollectedHeight
+	}
+	return 0
+}
+
+func (m *BudgetRecord) GetBudgetID() uint64 {
+	if m != nil {
+		return m.BudgetID
+	}
+	return 0
+}
+
+func (m *BudgetRecord) GetStatus() BudgetStatus {
+	if m != nil {
+		return m.Status
+	}
+	return BudgetStatusUnspecified
+}
+
+func (m *BudgetRecord) GetUnbonding() bool {
+	if m != nil {
+		return m.Unbonding
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.budget.v1beta1.GenesisState")
 	proto.RegisterType((*BudgetRecord)(nil), "cosmos.budget.v1beta1.BudgetRecord")
@@ -133,34 +203,62 @@ func init() {
 }
 
 var fileDescriptor_1e2aad40895529b5 = []byte{
-	// 431 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xb1, 0x8e, 0xd3, 0x30,
-	0x18, 0xc7, 0x63, 0x38, 0x9d, 0x20, 0x3d, 0x40, 0x0a, 0x54, 0xba, 0x3b, 0x71, 0x4e, 0x95, 0x0a,
-	0xa8, 0x84, 0x88, 0xd5, 0xb2, 0x95, 0x2d, 0x1d, 0x10, 0x5b, 0x15, 0x36, 0x96, 0xca, 0x49, 0x3e,
-	0x42, 0x44, 0x1c, 0x87, 0xd8, 0x41, 0xf4, 0x0d, 0x18, 0x79, 0x03, 0x3a, 0x22, 0x9e, 0x82, 0xb1,
-	0x63, 0x17, 0x24, 0xa6, 0x82, 0xd2, 0x85, 0xb9, 0x4f, 0x80, 0x62, 0x27, 0x55, 0x81, 0x76, 0x8a,
-	0xf3, 0xf9, 0xe7, 0xdf, 0xf7, 0xff, 0xe2, 0x98, 0x8f, 0x24, 0x64, 0x11, 0x14, 0x2c, 0xc9, 0x24,
-	0x09, 0xca, 0x28, 0x06, 0x49, 0xde, 0x0f, 0x03, 0x90, 0x74, 0x48, 0x62, 0xc8, 0x40, 0x24, 0xc2,
-	0xcd, 0x0b, 0x2e, 0xb9, 0xd5, 0x0d, 0xb9, 0x60, 0x5c, 0xb8, 0x1a, 0x72, 0x1b, 0xe8, 0xf2, 0x22,
-	0xe6, 0x3c, 0x4e, 0x81, 0x28, 0x28, 0x28, 0x5f, 0x13, 0x9a, 0xcd, 0xf5, 0x89, 0xcb, 0x7b, 0x31,
-	0x8f, 0xb9, 0x5a, 0x92, 0x7a, 0xd5, 0x54, 0x1f, 0x1e, 0x6f, 0xd8, 0xa8, 0x35, 0xf7, 0xe0, 0x38,
-	0xf7, 0xae, 0x84, 0xa2, 0x6d, 0x62, 0xff, 0xdb, 0x5f, 0x26, 0x0c, 0x84, 0xa4, 0x2c, 0x6f, 0x00,
-	0xac, 0x73, 0x93, 0x80, 0x0a, 0xd8, 0x19, 0x42, 0x9e, 0x64, 0x7a, 0xdf, 0xf9, 0x86, 0xcc, 0xb3,
-	0xe7, 0x7a, 0xd2, 0x97, 0x92, 0x4a, 0xb0, 0x9e, 0x99, 0xa7, 0x39, 0x2d, 0x28, 0x13, 0xe7, 0xa8,
-	0x87, 0x06, 0x9d, 0xd1, 0x95, 0x7b, 0x70, 0x72, 0x77, 0xaa, 0x20, 0xef, 0x64, 0xb9, 0xb6, 0x0d,
-	0xbf, 0x39, 0x62, 0x25, 0xe6, 0x6d, 0x8d, 0xcd, 0x0a, 0x08, 0x79, 0x11, 0x89, 0xf3, 0x6b, 0xbd,
-	0xeb, 0x83, 0xce, 0xa8, 0x7f, 0x44, 0xe2, 0xa9, 0x57, 0x5f, 0xb1, 0xde, 0x55, 0xad, 0xda, 0xae,
-	0xed, 0xee, 0x9c, 0xb2, 0x74, 0xec, 0xfc, 0x2d, 0x72, 0xfc, 0x5b, 0xc1, 0x1e, 0x2c, 0xc6, 0x37,
-	0x3e, 0x2e, 0x6c, 0xe3, 0xf7, 0xc2, 0x36, 0x9c, 0xef, 0xc8, 0x3c, 0xdb, 0x17, 0x59, 0x7d, 0xf3,
-	0x24, 0xa3, 0x0c, 0xd4, 0x00, 0x37, 0xbd, 0x3b, 0xdb, 0xb5, 0xdd, 0xd1, 0xca, 0xba, 0xea, 0xf8,
-	0x6a, 0xd3, 0xfa, 0x8c, 0xcc, 0xae, 0xe4, 0x92, 0xa6, 0xb3, 0x90, 0xa7, 0x29, 0x84, 0x12, 0xa2,
-	0x59, 0xfd, 0x5d, 0xda, 0xc8, 0x17, 0xbb, 0xc8, 0x54, 0xc0, 0x2e, 0xf0, 0x84, 0x27, 0x99, 0x37,
-	0x6d, 0x82, 0xde, 0xd7, 0xd6, 0x83, 0x16, 0xe7, 0xeb, 0x4f, 0x7b, 0x10, 0x27, 0xf2, 0x4d, 0x19,
-	0xb8, 0x21, 0x67, 0xa4, 0xb9, 0x06, 0xfd, 0x78, 0x22, 0xa2, 0xb7, 0x44, 0xce, 0x73, 0x10, 0x4a,
-	0x28, 0xfc, 0xbb, 0xca, 0x31, 0x69, 0x15, 0xaa, 0xe8, 0xbd, 0xf8, 0x52, 0x61, 0xb4, 0xac, 0x30,
-	0x5a, 0x55, 0x18, 0xfd, 0xaa, 0x30, 0xfa, 0xb4, 0xc1, 0xc6, 0x6a, 0x83, 0x8d, 0x1f, 0x1b, 0x6c,
-	0xbc, 0x7a, 0xbc, 0x27, 0xff, 0xff, 0x5f, 0xf9, 0xd0, 0x2e, 0x54, 0x97, 0xe0, 0x54, 0x5d, 0xf6,
-	0xd3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb5, 0x14, 0xfc, 0x9c, 0xef, 0x02, 0x00, 0x00,
+	// 865 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x31, 0x93, 0xdb, 0x44,
+	0x14, 0xb6, 0xee, 0x8c, 0xcf, 0xde, 0xf3, 0x39, 0xce, 0x26, 0x3e, 0xec, 0x23, 0x91, 0xcc, 0x32,
+	0x21, 0x06, 0x06, 0x89, 0x1c, 0x05, 0x33, 0x61, 0x28, 0xd0, 0x1d, 0x13, 0xc2, 0x00, 0xb9, 0xd9,
+	0xa4, 0xa2, 0xf1, 0xac, 0xa4, 0x8d, 0xac, 0xc1, 0xd2, 0x3a, 0xda, 0x75, 0x26, 0xae, 0x69, 0x28,
+	0x53, 0x51, 0x31, 0x43, 0x4a, 0x86, 0x96, 0x3f, 0x91, 0x32, 0x74, 0x54, 0x0e, 0xe3, 0x6b, 0xa8,
+	0xef, 0x17, 0x30, 0xda, 0x5d, 0x49, 0xb6, 0xef, 0x9c, 0x90, 0xca, 0xd2, 0xd3, 0xf7, 0xbe, 0xef,
+	0xed, 0xf7, 0xde, 0x5b, 0x83, 0x9b, 0x82, 0x26, 0x01, 0x4d, 0xe3, 0x28, 0x11, 0x8e, 0x37, 0x0d,
+	0x42, 0x2a, 0x9c, 0xc7, 0xb7, 0x3c, 0x2a, 0xc8, 0x2d, 0x27, 0xa4, 0x09, 0xe5, 0x11, 0xb7, 0x27,
+	0x29, 0x13, 0x0c, 0x76, 0x7c, 0xc6, 0x63, 0xc6, 0x6d, 0x05, 0xb2, 0x35, 0xe8, 0xa0, 0x17, 0x32,
+	0x16, 0x8e, 0xa9, 0x23, 0x41, 0xde, 0xf4, 0xa1, 0x43, 0x92, 0x99, 0xca, 0x38, 0xb8, 0x1a, 0xb2,
+	0x90, 0xc9, 0x47, 0x27, 0x7b, 0xd2, 0xd1, 0xf7, 0x37, 0x0b, 0x6a, 0x6a, 0x85, 0xbb, 0xb1, 0x19,
+	0xf7, 0x68, 0x4a, 0xd3, 0x5c, 0xc4, 0x5a, 0xd7, 0x17, 0x51, 0x4c, 0xb9, 0x20, 0xf1, 0x44, 0x03,
+	0x4c, 0x55, 0xb7, 0xe3, 0x11, 0x4e, 0x0b, 0x06, 0x9f, 0x45, 0x89, 0xfa, 0x8e, 0xfe, 0xaa, 0x81,
+	0xe6, 0x1d, 0x75, 0xd2, 0xfb, 0x82, 0x08, 0x0a, 0x3f, 0x07, 0xb5, 0x09, 0x49, 0x49, 0xcc, 0xbb,
+	0x46, 0xdf, 0x18, 0xec, 0x1e, 0x5e, 0xb7, 0x2f, 0x3c, 0xb9, 0x7d, 0x22, 0x41, 0x6e, 0xf5, 0xf9,
+	0xdc, 0xaa, 0x60, 0x9d, 0x02, 0x23, 0xd0, 0x52, 0xb0, 0x61, 0x4a, 0x7d, 0x96, 0x06, 0xbc, 0xbb,
+	0xd5, 0xdf, 0x1e, 0xec, 0x1e, 0xbe, 0xb7, 0x81, 0xc4, 0x95, 0xaf, 0x58, 0x62, 0xdd, 0xeb, 0x19,
+	0xd5, 0xd9, 0xdc, 0xea, 0xcc, 0x48, 0x3c, 0xbe, 0x8d, 0x56, 0x89, 0x10, 0xde, 0xf3, 0x96, 0xc0,
+	0x1c, 0xfe, 0x62, 0x00, 0x28, 0x98, 0x20, 0xe3, 0xa1, 0x37, 0x4d, 0x13, 0x1a, 0x0c, 0xb3, 0x43,
+	0xf1, 0xee, 0xb6, 0xd4, 0xeb, 0x15, 0x7a, 0x84, 0xd3, 0x42, 0xed, 0x88, 0x45, 0x89, 0xfb, 0x9d,
+	0x56, 0xe9, 0x29, 0x95, 0xf3, 0x14, 0xe8, 0x8f, 0x97, 0xd6, 0x20, 0x8c, 0xc4, 0x68, 0xea, 0xd9,
+	0x3e, 0x8b, 0x1d, 0x6d, 0xa0, 0xfa, 0xf9, 0x98, 0x07, 0x3f, 0x3a, 0x62, 0x36, 0xa1, 0x5c, 0xb2,
+	0x71, 0xdc, 0x96, 0x04, 0xae, 0xcc, 0x97, 0x11, 0xf8, 0x10, 0x5c, 0x1a, 0x13, 0x2e, 0x86, 0x74,
+	0xc2, 0xfc, 0xd1, 0x30, 0xeb, 0x47, 0xb7, 0x2a, 0x9d, 0x3c, 0xb0, 0x55, 0xb3, 0xec, 0xbc, 0x59,
+	0xf6, 0x83, 0xbc, 0x59, 0x2e, 0xd2, 0x55, 0xed, 0xab, 0xaa, 0xd6, 0x08, 0xd0, 0xd3, 0x97, 0x96,
+	0x81, 0xf7, 0xb2, 0xe8, 0x57, 0x59, 0x30, 0xcb, 0x83, 0xf7, 0xc0, 0x8e, 0x72, 0x84, 0x77, 0xdf,
+	0xea, 0x6f, 0xbf, 0xa2, 0x53, 0xca, 0x64, 0x77, 0x5f, 0x4b, 0xb4, 0x96, 0xed, 0xe5, 0x08, 0xe7,
+	0x2c, 0xf0, 0x1e, 0x68, 0x49, 0x5d, 0x6d, 0x7c, 0x14, 0x74, 0x6b, 0x7d, 0x63, 0x50, 0x75, 0x3f,
+	0x58, 0xcc, 0xad, 0xe6, 0xb7, 0x84, 0x0b, 0x45, 0x74, 0xf7, 0xb8, 0xec, 0xd1, 0x2a, 0x1e, 0xe1,
+	0xe6, 0xb8, 0x84, 0x05, 0x70, 0x06, 0xa0, 0xcf, 0xc6, 0x63, 0xea, 0x8b, 0x88, 0x25, 0xc5, 0x44,
+	0xec, 0xc8, 0x62, 0x6f, 0x6e, 0x28, 0xf6, 0xa8, 0x48, 0xd0, 0x53, 0xf1, 0xee, 0x6a, 0xbf, 0xce,
+	0x13, 0x22, 0x7c, 0xd9, 0x5f, 0x4b, 0xe2, 0xf0, 0x11, 0x68, 0x93, 0xd4, 0x1f, 0x45, 0x8f, 0x69,
+	0x30, 0xcc, 0x5d, 0xaa, 0x4b, 0xe1, 0x1b, 0x1b, 0x84, 0xbf, 0xd4, 0x70, 0xed, 0x96, 0xa5, 0x65,
+	0xdf, 0x56, 0xb2, 0xeb, 0x64, 0x08, 0x5f, 0x22, 0x2b, 0x09, 0xfc, 0x76, 0xfd, 0xe7, 0x67, 0x56,
+	0xe5, 0xdf, 0x67, 0x56, 0x05, 0xfd, 0x59, 0x03, 0xcd, 0xe5, 0xc9, 0x86, 0xbf, 0x19, 0xa0, 0xa3,
+	0x06, 0x4d, 0x57, 0x5a, 0x8c, 0xeb, 0xd6, 0xeb, 0xc6, 0xf5, 0x44, 0xd7, 0x71, 0x6d, 0x79, 0x5c,
+	0xd7, 0x58, 0xde, 0x6c, 0x62, 0xaf, 0x48, 0x8e, 0xa3, 0x9c, 0x42, 0x0d, 0xed, 0xaf, 0x06, 0x78,
+	0x27, 0xa0, 0x5c, 0x44, 0x09, 0x91, 0xde, 0xae, 0xd7, 0xa9, 0xd6, 0xea, 0x93, 0x0d, 0xde, 0x1d,
+	0x97, 0x99, 0xab, 0xbc, 0xee, 0x87, 0xba, 0x7c, 0xa4, 0xca, 0x7f, 0x85, 0x04, 0xc2, 0xbd, 0x60,
+	0x13, 0x0d, 0xfc, 0x0c, 0xec, 0x26, 0xf4, 0x89, 0x18, 0x4e, 0x68, 0x1a, 0xb1, 0x40, 0xee, 0x53,
+	0xd5, 0xdd, 0x3f, 0x9b, 0x5b, 0x50, 0xf1, 0x2e, 0x7d, 0x44, 0x18, 0x64, 0x6f, 0x27, 0xf2, 0x05,
+	0xfe, 0x64, 0x80, 0x46, 0x4a, 0x63, 0x12, 0x65, 0x97, 0xa9, 0xde, 0x93, 0x6b, 0x17, 0xba, 0x7d,
+	0x4c, 0x7d, 0x69, 0xf8, 0x1d, 0x5d, 0x71, 0x5b, 0x31, 0x17, 0xc9, 0x99, 0xc9, 0x1f, 0xfd, 0x0f,
+	0x93, 0x35, 0x0f, 0xc7, 0xa5, 0x2e, 0x7c, 0x00, 0x3a, 0x72, 0x53, 0xca, 0x23, 0x8f, 0x68, 0x14,
+	0x8e, 0x84, 0x5c, 0xb0, 0x6d, 0xb7, 0x5f, 0xf6, 0xf7, 0x42, 0x18, 0xc2, 0x57, 0xb2, 0x78, 0xe1,
+	0xc9, 0xd7, 0x32, 0x0a, 0xbf, 0x00, 0x8d, 0x72, 0x55, 0x77, 0xa4, 0x25, 0xfd, 0xc5, 0xdc, 0xaa,
+	0x2f, 0xad, 0x69, 0x7b, 0xe5, 0x2a, 0xcd, 0x36, 0xb4, 0xee, 0xe5, 0xdb, 0xf9, 0x3d, 0xa8, 0x71,
+	0x41, 0xc4, 0x34, 0x5b, 0x0c, 0x63, 0xd0, 0x7a, 0xcd, 0x1d, 0x7d, 0x5f, 0x42, 0xdd, 0xcb, 0x67,
+	0x73, 0x6b, 0x4f, 0x91, 0xaa, 0x64, 0x84, 0x35, 0x0b, 0x3c, 0x04, 0x8d, 0x69, 0xe2, 0xb1, 0x24,
+	0x88, 0x92, 0xb0, 0xdb, 0xe8, 0x1b, 0x83, 0xba, 0x7b, 0xb5, 0x2c, 0xa1, 0xf8, 0x84, 0x70, 0x09,
+	0xfb, 0xa6, 0x5a, 0x37, 0xda, 0x5b, 0xb8, 0x9a, 0x90, 0x98, 0xba, 0x77, 0x7f, 0x5f, 0x98, 0xc6,
+	0xf3, 0x85, 0x69, 0xbc, 0x58, 0x98, 0xc6, 0x3f, 0x0b, 0xd3, 0x78, 0x7a, 0x6a, 0x56, 0x5e, 0x9c,
+	0x9a, 0x95, 0xbf, 0x4f, 0xcd, 0xca, 0x0f, 0xcb, 0xd6, 0x9f, 0xff, 0x6b, 0x7c, 0x92, 0x3f, 0xc8,
+	0x1e, 0x78, 0x35, 0x79, 0xc3, 0x7e, 0xfa, 0xdf, 0x00, 0x4b, 0xbd, 0x79, 0xc2, 0xde, 0x07, 0x00,
+	0x00,
 }
 
 func (this *BudgetRecord) Equal(that interface{}) bool {
@@ -182,9 +280,6 @@ func (this *BudgetRecord) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.Name != that1.Name {
-		return false
-	}
 	if len(this.TotalCollectedCoins) != len(that1.TotalCollectedCoins) {
 		return false
 	}
@@ -193,6 +288,37 @@ func (this *BudgetRecord) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.DestinationCollectedCoins) != len(that1.DestinationCollectedCoins) {
+		return false
+	}
+	for i := range this.DestinationCollectedCoins {
+		if !this.DestinationCollectedCoins[i].Equal(&that1.DestinationCollectedCoins[i]) {
+			return false
+		}
+	}
+	if this.NextPeriod != that1.NextPeriod {
+		return false
+	}
+	if len(this.Remainder) != len(that1.Remainder) {
+		return false
+	}
+	for i := range this.Remainder {
+		if !this.Remainder[i].Equal(&that1.Remainder[i]) {
+			return false
+		}
+	}
+	if this.LastCollectedHeight != that1.LastCollectedHeight {
+		return false
+	}
+	if this.BudgetID != that1.BudgetID {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.Unbonding != that1.Unbonding {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -215,6 +341,75 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ArchivedBudgets) > 0 {
+		for iNdEx := len(m.ArchivedBudgets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ArchivedBudgets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CollectionRecords) > 0 {
+		for iNdEx := len(m.CollectionRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollectionRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.LastBudgetID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastBudgetID))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Budgets) > 0 {
+		for iNdEx := len(m.Budgets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Budgets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastEpochTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastEpochTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintGenesis(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.TotalBurnedCoins) > 0 {
+		for iNdEx := len(m.TotalBurnedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalBurnedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.BudgetRecords) > 0 {
 		for iNdEx := len(m.BudgetRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -262,6 +457,64 @@ func (m *BudgetRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Unbonding {
+		i--
+		if m.Unbonding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Status != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BudgetID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BudgetID))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LastCollectedHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastCollectedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Remainder) > 0 {
+		for iNdEx := len(m.Remainder) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remainder[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.NextPeriod != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPeriod))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DestinationCollectedCoins) > 0 {
+		for iNdEx := len(m.DestinationCollectedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DestinationCollectedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.TotalCollectedCoins) > 0 {
 		for iNdEx := len(m.TotalCollectedCoins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -276,13 +529,6 @@ func (m *BudgetRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
@@ -311,6 +557,35 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TotalBurnedCoins) > 0 {
+		for _, e := range m.TotalBurnedCoins {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastEpochTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Budgets) > 0 {
+		for _, e := range m.Budgets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastBudgetID != 0 {
+		n += 1 + sovGenesis(uint64(m.LastBudgetID))
+	}
+	if len(m.CollectionRecords) > 0 {
+		for _, e := range m.CollectionRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ArchivedBudgets) > 0 {
+		for _, e := range m.ArchivedBudgets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -320,16 +595,39 @@ func (m *BudgetRecord) Size() (n int) {
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
-	}
 	if len(m.TotalCollectedCoins) > 0 {
 		for _, e := range m.TotalCollectedCoins {
 			l = e.Size()
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DestinationCollectedCoins) > 0 {
+		for _, e := range m.DestinationCollectedCoins {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextPeriod != 0 {
+		n += 1 + sovGenesis(uint64(m.NextPeriod))
+	}
+	if len(m.Remainder) > 0 {
+		for _, e := range m.Remainder {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastCollectedHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LastCollectedHeight))
+	}
+	if m.BudgetID != 0 {
+		n += 1 + sovGenesis(uint64(m.BudgetID))
+	}
+	if m.Status != 0 {
+		n += 1 + sovGenesis(uint64(m.Status))
+	}
+	if m.Unbonding {
+		n += 2
+	}
 	return n
 }
 
@@ -435,6 +733,194 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurnedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalBurnedCoins = append(m.TotalBurnedCoins, types.Coin{})
+			if err := m.TotalBurnedCoins[len(m.TotalBurnedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpochTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastEpochTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budgets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Budgets = append(m.Budgets, Budget{})
+			if err := m.Budgets[len(m.Budgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBudgetID", wireType)
+			}
+			m.LastBudgetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastBudgetID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectionRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectionRecords = append(m.CollectionRecords, CollectionRecord{})
+			if err := m.CollectionRecords[len(m.CollectionRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedBudgets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArchivedBudgets = append(m.ArchivedBudgets, ArchivedBudget{})
+			if err := m.ArchivedBudgets[len(m.ArchivedBudgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -485,11 +971,45 @@ func (m *BudgetRecord) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: BudgetRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCollectedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalCollectedCoins = append(m.TotalCollectedCoins, types.Coin{})
+			if err := m.TotalCollectedCoins[len(m.TotalCollectedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCollectedCoins", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -499,27 +1019,48 @@ func (m *BudgetRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.DestinationCollectedCoins = append(m.DestinationCollectedCoins, DestinationCollectedCoins{})
+			if err := m.DestinationCollectedCoins[len(m.DestinationCollectedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPeriod", wireType)
+			}
+			m.NextPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalCollectedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remainder", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -546,11 +1087,88 @@ func (m *BudgetRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalCollectedCoins = append(m.TotalCollectedCoins, types.Coin{})
-			if err := m.TotalCollectedCoins[len(m.TotalCollectedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Remainder = append(m.Remainder, types.DecCoin{})
+			if err := m.Remainder[len(m.Remainder)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCollectedHeight", wireType)
+			}
+			m.LastCollectedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastCollectedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BudgetID", wireType)
+			}
+			m.BudgetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BudgetID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BudgetStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unbonding = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -12,7 +12,7 @@ import (
 )
 
 func TestValidateGenesis(t *testing.T) {
-	startTime, _ := time.Parse(time.RFC3339, "0000-01-01T00:00:00Z")
+	startTime, _ := time.Parse(time.RFC3339, "0001-01-01T00:00:00Z")
 	endTime, _ := time.Parse(time.RFC3339, "9999-12-31T00:00:00Z")
 	testCases := []struct {
 		name        string
@@ -30,8 +30,10 @@ func TestValidateGenesis(t *testing.T) {
 			"normal budget case",
 			func(genState *types.GenesisState) {
 				genState.Params = types.DefaultParams()
-				genState.Params.Budgets = []types.Budget{
+				genState.LastBudgetID = 2
+				genState.Budgets = []types.Budget{
 					{
+						ID:                 1,
 						Name:               "budget1",
 						Rate:               sdk.NewDecWithPrec(5, 2), // 5%
 						SourceAddress:      sdk.AccAddress(crypto.AddressHash([]byte("SourceAddress"))).String(),
@@ -47,8 +49,10 @@ func TestValidateGenesis(t *testing.T) {
 			"invalid budget case",
 			func(genState *types.GenesisState) {
 				genState.Params = types.DefaultParams()
-				genState.Params.Budgets = []types.Budget{
+				genState.LastBudgetID = 2
+				genState.Budgets = []types.Budget{
 					{
+						ID:                 1,
 						Name:               "budget1",
 						Rate:               sdk.NewDecWithPrec(5, 2), // 5%
 						SourceAddress:      "cosmos1invalidaddress",
@@ -64,8 +68,10 @@ func TestValidateGenesis(t *testing.T) {
 			"duplicate budget name",
 			func(genState *types.GenesisState) {
 				genState.Params = types.DefaultParams()
-				genState.Params.Budgets = []types.Budget{
+				genState.LastBudgetID = 2
+				genState.Budgets = []types.Budget{
 					{
+						ID:                 1,
 						Name:               "budget1",
 						Rate:               sdk.NewDecWithPrec(5, 2), // 5%
 						SourceAddress:      sdk.AccAddress(crypto.AddressHash([]byte("SourceAddress"))).String(),
@@ -74,6 +80,7 @@ func TestValidateGenesis(t *testing.T) {
 						EndTime:            endTime,
 					},
 					{
+						ID:                 2,
 						Name:               "budget1",
 						Rate:               sdk.NewDecWithPrec(5, 2), // 5%
 						SourceAddress:      sdk.AccAddress(crypto.AddressHash([]byte("SourceAddress"))).String(),
@@ -86,31 +93,247 @@ func TestValidateGenesis(t *testing.T) {
 			"budget1: duplicate budget name",
 		},
 		{
-			"invalid budget name case",
+			"invalid budget id case",
+			func(genState *types.GenesisState) {
+				genState.Params = types.DefaultParams()
+				genState.LastBudgetID = 1
+				genState.Budgets = []types.Budget{
+					{
+						ID:                 2,
+						Name:               "budget1",
+						Rate:               sdk.NewDecWithPrec(5, 2), // 5%
+						SourceAddress:      sdk.AccAddress(crypto.AddressHash([]byte("SourceAddress"))).String(),
+						DestinationAddress: sdk.AccAddress(crypto.AddressHash([]byte("DestinationAddress"))).String(),
+						StartTime:          startTime,
+						EndTime:            endTime,
+					},
+				}
+			},
+			"budget budget1 must have an id from 1 to the last budget id 1: invalid budget id",
+		},
+		{
+			"duplicate budget id case",
+			func(genState *types.GenesisState) {
+				genState.Params = types.DefaultParams()
+				genState.LastBudgetID = 2
+				genState.Budgets = []types.Budget{
+					{
+						ID:                 1,
+						Name:               "budget1",
+						Rate:               sdk.NewDecWithPrec(5, 2), // 5%
+						SourceAddress:      sdk.AccAddress(crypto.AddressHash([]byte("SourceAddress"))).String(),
+						DestinationAddress: sdk.AccAddress(crypto.AddressHash([]byte("DestinationAddress"))).String(),
+						StartTime:          startTime,
+						EndTime:            endTime,
+					},
+					{
+						ID:                 1,
+						Name:               "budget2",
+						Rate:               sdk.NewDecWithPrec(5, 2), // 5%
+						SourceAddress:      sdk.AccAddress(crypto.AddressHash([]byte("SourceAddress"))).String(),
+						DestinationAddress: sdk.AccAddress(crypto.AddressHash([]byte("DestinationAddress"))).String(),
+						StartTime:          startTime,
+						EndTime:            endTime,
+					},
+				}
+			},
+			"duplicate budget id 1: invalid budget id",
+		},
+		{
+			"invalid record budget id case",
 			func(genState *types.GenesisState) {
 				genState.Params = types.DefaultParams()
 				genState.BudgetRecords = []types.BudgetRecord{
 					{
-						Name:                "invalid name",
+						BudgetID:            1,
 						TotalCollectedCoins: nil,
 					},
 				}
 			},
-			"invalid name: budget name only allows letters, digits, and dash(-) without spaces and the maximum length is 50",
+			"record must have a budget id from 1 to the last budget id 0: invalid budget id",
+		},
+		{
+			"record without budget case",
+			func(genState *types.GenesisState) {
+				genState.Params = types.DefaultParams()
+				genState.LastBudgetID = 3
+				genState.BudgetRecords = []types.BudgetRecord{
+					{
+						BudgetID:            3,
+						TotalCollectedCoins: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+					},
+				}
+			},
+			"budget 3 of record not found: budget not found",
+		},
+		{
+			"archived budget case",
+			func(genState *types.GenesisState) {
+				genState.Params = types.DefaultParams()
+				genState.LastBudgetID = 3
+				genState.ArchivedBudgets = []types.ArchivedBudget{
+					{
+						Budget:              types.Budget{ID: 3, Name: "budget3"},
+						Status:              types.BudgetStatusRemoved,
+						TotalCollectedCoins: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+					},
+				}
+			},
+			"",
+		},
+		{
+			"invalid archived budget status case",
+			func(genState *types.GenesisState) {
+				genState.Params = types.DefaultParams()
+				genState.LastBudgetID = 3
+				genState.ArchivedBudgets = []types.ArchivedBudget{
+					{
+						Budget: types.Budget{ID: 3, Name: "budget3"},
+						Status: types.BudgetStatusActive,
+					},
+				}
+			},
+			"invalid status BUDGET_STATUS_ACTIVE of archived budget 3: invalid budget status",
+		},
+		{
+			"duplicate archived budget id case",
+			func(genState *types.GenesisState) {
+				genState.Params = types.DefaultParams()
+				genState.LastBudgetID = 2
+				genState.Budgets = []types.Budget{
+					{
+						ID:                 1,
+						Name:               "budget1",
+						Rate:               sdk.NewDecWithPrec(5, 2), // 5%
+						SourceAddress:      sdk.AccAddress(crypto.AddressHash([]byte("SourceAddress"))).String(),
+						DestinationAddress: sdk.AccAddress(crypto.AddressHash([]byte("DestinationAddress"))).String(),
+						StartTime:          startTime,
+						EndTime:            endTime,
+					},
+				}
+				genState.ArchivedBudgets = []types.ArchivedBudget{
+					{
+						Budget: types.Budget{ID: 1, Name: "budget1"},
+						Status: types.BudgetStatusExpired,
+					},
+				}
+			},
+			"duplicate budget id 1: invalid budget id",
+		},
+		{
+			"invalid record status case",
+			func(genState *types.GenesisState) {
+				genState.Params = types.DefaultParams()
+				genState.LastBudgetID = 1
+				genState.Budgets = []types.Budget{
+					{
+						ID:                 1,
+						Name:               "budget1",
+						Rate:               sdk.NewDecWithPrec(5, 2), // 5%
+						SourceAddress:      sdk.AccAddress(crypto.AddressHash([]byte("SourceAddress"))).String(),
+						DestinationAddress: sdk.AccAddress(crypto.AddressHash([]byte("DestinationAddress"))).String(),
+						StartTime:          startTime,
+						EndTime:            endTime,
+					},
+				}
+				genState.BudgetRecords = []types.BudgetRecord{{BudgetID: 1, Status: types.BudgetStatusExpired}}
+			},
+			"invalid status BUDGET_STATUS_EXPIRED of budget 1: invalid budget status",
 		},
 		{
 			"invalid total_collected_coin case",
 			func(genState *types.GenesisState) {
 				genState.Params = types.DefaultParams()
+				genState.LastBudgetID = 1
 				genState.BudgetRecords = []types.BudgetRecord{
 					{
-						Name:                "budget1",
+						BudgetID:            1,
 						TotalCollectedCoins: sdk.Coins{sdk.NewCoin("stake", sdk.ZeroInt())},
 					},
 				}
 			},
 			"invalid total collected coins 0stake: coin 0stake amount is not positive: invalid coins",
 		},
+		{
+			"invalid total_burned_coins case",
+			func(genState *types.GenesisState) {
+				genState.Params = types.DefaultParams()
+				genState.TotalBurnedCoins = sdk.Coins{sdk.NewCoin("stake", sdk.ZeroInt())}
+			},
+			"invalid total burned coins 0stake: coin 0stake amount is not positive: invalid coins",
+		},
+		{
+			"invalid last_collected_height case",
+			func(genState *types.GenesisState) {
+				genState.Params = types.DefaultParams()
+				genState.LastBudgetID = 1
+				genState.BudgetRecords = []types.BudgetRecord{
+					{
+						BudgetID:            1,
+						LastCollectedHeight: -1,
+					},
+				}
+			},
+			"invalid last collected height -1: invalid budget epoch",
+		},
+		{
+			"normal collection record case",
+			func(genState *types.GenesisState) {
+				genState.Params = types.DefaultParams()
+				genState.LastBudgetID = 1
+				genState.CollectionRecords = []types.CollectionRecord{
+					{
+						BudgetID:       1,
+						Height:         10,
+						SourceBalances: sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+						CollectedCoins: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+					},
+				}
+			},
+			"",
+		},
+		{
+			"invalid collection record budget id case",
+			func(genState *types.GenesisState) {
+				genState.Params = types.DefaultParams()
+				genState.LastBudgetID = 1
+				genState.CollectionRecords = []types.CollectionRecord{{BudgetID: 2, Height: 10}}
+			},
+			"collection record must have a budget id from 1 to the last budget id 1: invalid budget id",
+		},
+		{
+			"invalid collection record height case",
+			func(genState *types.GenesisState) {
+				genState.Params = types.DefaultParams()
+				genState.LastBudgetID = 1
+				genState.CollectionRecords = []types.CollectionRecord{{BudgetID: 1, Height: -1}}
+			},
+			"invalid collection record height -1: invalid budget epoch",
+		},
+		{
+			"duplicate collection record case",
+			func(genState *types.GenesisState) {
+				genState.Params = types.DefaultParams()
+				genState.LastBudgetID = 1
+				genState.CollectionRecords = []types.CollectionRecord{{BudgetID: 1, Height: 10}, {BudgetID: 1, Height: 10}}
+			},
+			"duplicate collection record of budget id 1 at height 10: invalid request",
+		},
+		{
+			"invalid collected coins case",
+			func(genState *types.GenesisState) {
+				genState.Params = types.DefaultParams()
+				genState.LastBudgetID = 1
+				genState.CollectionRecords = []types.CollectionRecord{
+					{
+						BudgetID:       1,
+						Height:         10,
+						CollectedCoins: sdk.Coins{sdk.NewCoin("stake", sdk.ZeroInt())},
+					},
+				}
+			},
+			"invalid collected coins 0stake: coin 0stake amount is not positive: invalid coins",
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -2,6 +2,9 @@ package types
 
 import (
 	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -20,18 +23,207 @@ const (
 
 var (
 	// Keys for store prefixes
-	TotalCollectedCoinsKeyPrefix = []byte{0x11}
+	TotalCollectedCoinsKeyPrefix           = []byte{0x11}
+	DestinationCollectedCoinsKeyPrefix     = []byte{0x12}
+	NextPeriodKeyPrefix                    = []byte{0x13}
+	RemainderKeyPrefix                     = []byte{0x14}
+	TotalBurnedCoinsKey                    = []byte{0x15}
+	LastCollectedHeightKeyPrefix           = []byte{0x16}
+	LastEpochTimeKey                       = []byte{0x17}
+	LastBudgetIDKey                        = []byte{0x18}
+	BudgetKeyPrefix                        = []byte{0x19}
+	BudgetByNameIndexKeyPrefix             = []byte{0x1a}
+	CollectionRecordKeyPrefix              = []byte{0x1b}
+	CollectionRecordByHeightIndexKeyPrefix = []byte{0x1c}
+	BudgetStatusKeyPrefix                  = []byte{0x1d}
+	ArchivedBudgetKeyPrefix                = []byte{0x1e}
+	BudgetBySourceIndexKeyPrefix           = []byte{0x1f}
+	BudgetByDestinationIndexKeyPrefix      = []byte{0x20}
+	UnbondingBudgetIndexKeyPrefix          = []byte{0x21}
+	BudgetByIBCEscrowIndexKeyPrefix        = []byte{0x22}
 )
 
+// GetBudgetKey creates the key for a budget.
+func GetBudgetKey(budgetID uint64) []byte {
+	return append(BudgetKeyPrefix, sdk.Uint64ToBigEndian(budgetID)...)
+}
+
+// GetBudgetByNameIndexKey creates the index key for a budget by its name.
+func GetBudgetByNameIndexKey(budgetName string) []byte {
+	return append(BudgetByNameIndexKeyPrefix, []byte(budgetName)...)
+}
+
 // GetTotalCollectedCoinsKey creates the key for the total collected coins for a budget.
-func GetTotalCollectedCoinsKey(budgetName string) []byte {
-	return append(TotalCollectedCoinsKeyPrefix, []byte(budgetName)...)
+func GetTotalCollectedCoinsKey(budgetID uint64) []byte {
+	return append(TotalCollectedCoinsKeyPrefix, sdk.Uint64ToBigEndian(budgetID)...)
 }
 
-// ParseTotalCollectedCoinsKey parses the total collected coins key and returns the budget name.
-func ParseTotalCollectedCoinsKey(key []byte) (budgetName string) {
+// ParseTotalCollectedCoinsKey parses the total collected coins key and returns the budget id.
+func ParseTotalCollectedCoinsKey(key []byte) (budgetID uint64) {
 	if !bytes.HasPrefix(key, TotalCollectedCoinsKeyPrefix) {
 		panic("key does not have proper prefix")
 	}
-	return string(key[1:])
+	return sdk.BigEndianToUint64(key[1:])
+}
+
+// GetDestinationCollectedCoinsKey creates the key for the total collected coins for a destination of a budget.
+func GetDestinationCollectedCoinsKey(budgetID uint64, destinationAcc sdk.AccAddress) []byte {
+	return append(GetDestinationCollectedCoinsByBudgetKey(budgetID), destinationAcc...)
+}
+
+// GetDestinationCollectedCoinsByBudgetKey creates the key prefix for the total collected coins for
+// the destinations of a budget.
+func GetDestinationCollectedCoinsByBudgetKey(budgetID uint64) []byte {
+	return append(DestinationCollectedCoinsKeyPrefix, sdk.Uint64ToBigEndian(budgetID)...)
+}
+
+// ParseDestinationCollectedCoinsKey parses the destination collected coins key and returns the budget id
+// and the destination address.
+func ParseDestinationCollectedCoinsKey(key []byte) (budgetID uint64, destinationAcc sdk.AccAddress) {
+	if !bytes.HasPrefix(key, DestinationCollectedCoinsKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	return sdk.BigEndianToUint64(key[1:9]), key[9:]
+}
+
+// GetNextPeriodKey creates the key for the next recurrence period of a budget.
+func GetNextPeriodKey(budgetID uint64) []byte {
+	return append(NextPeriodKeyPrefix, sdk.Uint64ToBigEndian(budgetID)...)
+}
+
+// ParseNextPeriodKey parses the next period key and returns the budget id.
+func ParseNextPeriodKey(key []byte) (budgetID uint64) {
+	if !bytes.HasPrefix(key, NextPeriodKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	return sdk.BigEndianToUint64(key[1:])
+}
+
+// GetRemainderKey creates the key for the remainder of a budget.
+func GetRemainderKey(budgetID uint64) []byte {
+	return append(RemainderKeyPrefix, sdk.Uint64ToBigEndian(budgetID)...)
+}
+
+// ParseRemainderKey parses the remainder key and returns the budget id.
+func ParseRemainderKey(key []byte) (budgetID uint64) {
+	if !bytes.HasPrefix(key, RemainderKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	return sdk.BigEndianToUint64(key[1:])
+}
+
+// GetLastCollectedHeightKey creates the key for the last collected height of a budget.
+func GetLastCollectedHeightKey(budgetID uint64) []byte {
+	return append(LastCollectedHeightKeyPrefix, sdk.Uint64ToBigEndian(budgetID)...)
+}
+
+// ParseLastCollectedHeightKey parses the last collected height key and returns the budget id.
+func ParseLastCollectedHeightKey(key []byte) (budgetID uint64) {
+	if !bytes.HasPrefix(key, LastCollectedHeightKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	return sdk.BigEndianToUint64(key[1:])
+}
+
+// GetCollectionRecordKey creates the key for the collection record of a budget at a height.
+func GetCollectionRecordKey(budgetID uint64, height int64) []byte {
+	return append(GetCollectionRecordsByBudgetKey(budgetID), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetCollectionRecordsByBudgetKey creates the key prefix for the collection records of a budget.
+func GetCollectionRecordsByBudgetKey(budgetID uint64) []byte {
+	return append(CollectionRecordKeyPrefix, sdk.Uint64ToBigEndian(budgetID)...)
+}
+
+// ParseCollectionRecordKey parses the collection record key and returns the budget id and the height.
+func ParseCollectionRecordKey(key []byte) (budgetID uint64, height int64) {
+	if !bytes.HasPrefix(key, CollectionRecordKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	return sdk.BigEndianToUint64(key[1:9]), int64(sdk.BigEndianToUint64(key[9:]))
+}
+
+// GetCollectionRecordByHeightIndexKey creates the index key for the collection record of a budget by its height.
+func GetCollectionRecordByHeightIndexKey(height int64, budgetID uint64) []byte {
+	return append(GetCollectionRecordsByHeightIndexKey(height), sdk.Uint64ToBigEndian(budgetID)...)
+}
+
+// GetCollectionRecordsByHeightIndexKey creates the index key prefix for the collection records at a height.
+func GetCollectionRecordsByHeightIndexKey(height int64) []byte {
+	return append(CollectionRecordByHeightIndexKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// ParseCollectionRecordByHeightIndexKey parses the collection record by height index key and returns
+// the height and the budget id.
+func ParseCollectionRecordByHeightIndexKey(key []byte) (height int64, budgetID uint64) {
+	if !bytes.HasPrefix(key, CollectionRecordByHeightIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	return int64(sdk.BigEndianToUint64(key[1:9])), sdk.BigEndianToUint64(key[9:])
+}
+
+// GetBudgetStatusKey creates the key for the lifecycle status of a budget.
+func GetBudgetStatusKey(budgetID uint64) []byte {
+	return append(BudgetStatusKeyPrefix, sdk.Uint64ToBigEndian(budgetID)...)
+}
+
+// ParseBudgetStatusKey parses the budget status key and returns the budget id.
+func ParseBudgetStatusKey(key []byte) (budgetID uint64) {
+	if !bytes.HasPrefix(key, BudgetStatusKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	return sdk.BigEndianToUint64(key[1:])
+}
+
+// GetArchivedBudgetKey creates the key for an archived budget.
+func GetArchivedBudgetKey(budgetID uint64) []byte {
+	return append(ArchivedBudgetKeyPrefix, sdk.Uint64ToBigEndian(budgetID)...)
+}
+
+// GetUnbondingBudgetIndexKey creates the key for the index of an archived budget whose delegations are unbonding.
+func GetUnbondingBudgetIndexKey(budgetID uint64) []byte {
+	return append(UnbondingBudgetIndexKeyPrefix, sdk.Uint64ToBigEndian(budgetID)...)
+}
+
+// ParseUnbondingBudgetIndexKey parses the unbonding budget index key and returns the budget id.
+func ParseUnbondingBudgetIndexKey(key []byte) (budgetID uint64) {
+	if !bytes.HasPrefix(key, UnbondingBudgetIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	return sdk.BigEndianToUint64(key[1:])
+}
+
+// GetBudgetByIBCEscrowIndexKey creates the key for the index of a budget by its IBC escrow address.
+func GetBudgetByIBCEscrowIndexKey(escrowAcc sdk.AccAddress) []byte {
+	return append(BudgetByIBCEscrowIndexKeyPrefix, address.MustLengthPrefix(escrowAcc)...)
+}
+
+// GetBudgetBySourceIndexKey creates the key for the index of a budget by its source address.
+func GetBudgetBySourceIndexKey(sourceAcc sdk.AccAddress, budgetID uint64) []byte {
+	return append(GetBudgetsBySourceIndexKey(sourceAcc), sdk.Uint64ToBigEndian(budgetID)...)
+}
+
+// GetBudgetsBySourceIndexKey creates the prefix key for the indexes of the budgets by a source address.
+func GetBudgetsBySourceIndexKey(sourceAcc sdk.AccAddress) []byte {
+	return append(BudgetBySourceIndexKeyPrefix, address.MustLengthPrefix(sourceAcc)...)
+}
+
+// GetBudgetByDestinationIndexKey creates the key for the index of a budget by one of its destination addresses.
+func GetBudgetByDestinationIndexKey(destinationAcc sdk.AccAddress, budgetID uint64) []byte {
+	return append(GetBudgetsByDestinationIndexKey(destinationAcc), sdk.Uint64ToBigEndian(budgetID)...)
+}
+
+// GetBudgetsByDestinationIndexKey creates the prefix key for the indexes of the budgets by a destination address.
+func GetBudgetsByDestinationIndexKey(destinationAcc sdk.AccAddress) []byte {
+	return append(BudgetByDestinationIndexKeyPrefix, address.MustLengthPrefix(destinationAcc)...)
+}
+
+// ParseBudgetByAddressIndexKey parses the key for the index of a budget by its source or destination address
+// and returns the address and the budget id.
+func ParseBudgetByAddressIndexKey(key []byte) (acc sdk.AccAddress, budgetID uint64) {
+	if !bytes.HasPrefix(key, BudgetBySourceIndexKeyPrefix) && !bytes.HasPrefix(key, BudgetByDestinationIndexKeyPrefix) {
+		panic("key does not have proper prefix")
+	}
+	addrLen := int(key[1])
+	return key[2 : 2+addrLen], sdk.BigEndianToUint64(key[2+addrLen:])
 }
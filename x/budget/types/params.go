@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"gopkg.in/yaml.v2"
 
@@ -13,14 +14,37 @@ import (
 const (
 	// MaxBudgetNameLength is the maximum length of the name of each budget.
 	MaxBudgetNameLength int = 50
+	// MaxBudgetDescriptionLength is the maximum length of the description of each budget.
+	MaxBudgetDescriptionLength int = 1000
+	// MaxBudgetLinkLength is the maximum length of the link of each budget.
+	MaxBudgetLinkLength int = 256
+	// MaxBudgetOwnerLength is the maximum length of the owner of each budget.
+	MaxBudgetOwnerLength int = 140
+	// MaxBudgetTags is the maximum number of the tags of each budget.
+	MaxBudgetTags int = 10
+	// MaxBudgetTagLength is the maximum length of each tag of a budget.
+	MaxBudgetTagLength int = 32
 	// DefaultEpochBlocks is the default epoch blocks.
 	DefaultEpochBlocks uint32 = 1
+	// DefaultHistoryRetentionBlocks is the default history retention blocks.
+	DefaultHistoryRetentionBlocks uint64 = 100000
 )
 
 // Parameter store keys
 var (
-	KeyBudgets     = []byte("Budgets")
-	KeyEpochBlocks = []byte("EpochBlocks")
+	KeyEpochBlocks            = []byte("EpochBlocks")
+	KeyEpochMode              = []byte("EpochMode")
+	KeyEpochDuration          = []byte("EpochDuration")
+	KeySourceProcessingModes  = []byte("SourceProcessingModes")
+	KeySourceReserves         = []byte("SourceReserves")
+	KeyHistoryRetentionBlocks = []byte("HistoryRetentionBlocks")
+)
+
+var (
+	// MinBudgetTime is the minimum start time of a budget, which is the minimum time that can be stored.
+	MinBudgetTime = time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)
+	// MaxBudgetTime is the maximum end time of a budget, which is the maximum time that can be stored.
+	MaxBudgetTime = time.Date(9999, 12, 31, 23, 59, 59, 999999999, time.UTC)
 )
 
 var _ paramstypes.ParamSet = (*Params)(nil)
@@ -33,16 +57,23 @@ func ParamKeyTable() paramstypes.KeyTable {
 // DefaultParams returns the default budget module parameters.
 func DefaultParams() Params {
 	return Params{
-		Budgets:     []Budget{},
-		EpochBlocks: DefaultEpochBlocks,
+		EpochBlocks:            DefaultEpochBlocks,
+		SourceProcessingModes:  []SourceProcessingMode{},
+		SourceReserves:         []SourceReserve{},
+		EpochMode:              EpochModeBlocks,
+		HistoryRetentionBlocks: DefaultHistoryRetentionBlocks,
 	}
 }
 
 // ParamSetPairs implements paramstypes.ParamSet.
 func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 	return paramstypes.ParamSetPairs{
-		paramstypes.NewParamSetPair(KeyBudgets, &p.Budgets, ValidateBudgets),
 		paramstypes.NewParamSetPair(KeyEpochBlocks, &p.EpochBlocks, ValidateEpochBlocks),
+		paramstypes.NewParamSetPair(KeySourceProcessingModes, &p.SourceProcessingModes, ValidateSourceProcessingModes),
+		paramstypes.NewParamSetPair(KeySourceReserves, &p.SourceReserves, ValidateSourceReserves),
+		paramstypes.NewParamSetPair(KeyEpochMode, &p.EpochMode, ValidateEpochMode),
+		paramstypes.NewParamSetPair(KeyEpochDuration, &p.EpochDuration, ValidateEpochDuration),
+		paramstypes.NewParamSetPair(KeyHistoryRetentionBlocks, &p.HistoryRetentionBlocks, ValidateHistoryRetentionBlocks),
 	}
 }
 
@@ -58,7 +89,11 @@ func (p Params) Validate() error {
 		value     interface{}
 		validator func(interface{}) error
 	}{
-		{p.Budgets, ValidateBudgets},
+		{p.SourceProcessingModes, ValidateSourceProcessingModes},
+		{p.SourceReserves, ValidateSourceReserves},
+		{p.EpochMode, ValidateEpochMode},
+		{p.EpochDuration, ValidateEpochDuration},
+		{p.HistoryRetentionBlocks, ValidateHistoryRetentionBlocks},
 	} {
 		if err := v.validator(v.value); err != nil {
 			return err
@@ -67,13 +102,31 @@ func (p Params) Validate() error {
 	return nil
 }
 
-// ValidateBudgets validates budget name and total rate.
-// The total rate of budgets with the same source address must not exceed 1.
-func ValidateBudgets(i interface{}) error {
-	budgets, ok := i.([]Budget)
-	if !ok {
-		return fmt.Errorf("invalid parameter type: %T", i)
+// ProcessingMode returns the processing mode of the budgets for the source address.
+// Address references are compared by the addresses they resolve to.
+func (p Params) ProcessingMode(sourceAddress string) ProcessingMode {
+	for _, mode := range p.SourceProcessingModes {
+		if resolvedAddressKey(mode.SourceAddress) == resolvedAddressKey(sourceAddress) {
+			return mode.Mode
+		}
 	}
+	return ProcessingModeSharedSnapshot
+}
+
+// SourceReserve returns the reserve of the source address, which is the amount of coins that
+// budgets must leave in the source. Address references are compared by the addresses they resolve to.
+func (p Params) SourceReserve(sourceAddress string) sdk.Coins {
+	for _, reserve := range p.SourceReserves {
+		if resolvedAddressKey(reserve.SourceAddress) == resolvedAddressKey(sourceAddress) {
+			return reserve.Reserve
+		}
+	}
+	return nil
+}
+
+// ValidateBudgets validates budget name and total rate.
+// The total rate of each denom for budgets with the same source address must not exceed 1.
+func ValidateBudgets(budgets []Budget) error {
 	names := make(map[string]bool)
 	for _, budget := range budgets {
 		err := budget.Validate()
@@ -87,23 +140,44 @@ func ValidateBudgets(i interface{}) error {
 	}
 	budgetsBySourceMap := GetBudgetsBySourceMap(budgets)
 	for addr, budgetsBySource := range budgetsBySourceMap {
-		if budgetsBySource.TotalRate.GT(sdk.OneDec()) {
-			// If the TotalRate of Budgets with the same source address exceeds 1,
-			// recalculate and verify the TotalRate of Budgets with overlapping time ranges.
+		for _, denom := range budgetsBySource.Denoms() {
+			if !TotalDenomRate(budgetsBySource.Budgets, denom).GT(sdk.OneDec()) {
+				continue
+			}
+			// If the total rate of the denom for Budgets with the same source address exceeds 1,
+			// recalculate and verify the total rate of Budgets with overlapping time ranges, using
+			// the peak rate of each budget inside the overlapping time window.
 			for _, budget := range budgetsBySource.Budgets {
-				totalRate := sdk.ZeroDec()
-				for _, budgetToCheck := range budgetsBySource.Budgets {
-					if DateRangesOverlap(budget.StartTime, budget.EndTime, budgetToCheck.StartTime, budgetToCheck.EndTime) {
-						totalRate = totalRate.Add(budgetToCheck.Rate)
+				windowTimes := budgetsBySource.windowTimes(budget)
+				for i := 0; i < len(windowTimes)-1; i++ {
+					totalRate := sdk.ZeroDec()
+					for _, budgetToCheck := range budgetsBySource.Budgets {
+						startTime, endTime := windowTimes[i], windowTimes[i+1]
+						startTimeToCheck, endTimeToCheck := budgetToCheck.TimeRange()
+						if !DateRangesOverlap(startTime, endTime, startTimeToCheck, endTimeToCheck) ||
+							!budget.HeightRangeOverlaps(budgetToCheck) {
+							continue
+						}
+						if startTimeToCheck.After(startTime) {
+							startTime = startTimeToCheck
+						}
+						if endTimeToCheck.Before(endTime) {
+							endTime = endTimeToCheck
+						}
+						totalRate = totalRate.Add(budgetToCheck.PeakDenomRate(denom, startTime, endTime))
+					}
+					if totalRate.GT(sdk.OneDec()) {
+						if denom == "" {
+							return sdkerrors.Wrapf(
+								ErrInvalidTotalBudgetRate,
+								"total rate for source address %s must not exceed 1: %v", addr, totalRate)
+						}
+						return sdkerrors.Wrapf(
+							ErrInvalidTotalBudgetRate,
+							"total rate of %s for source address %s must not exceed 1: %v", denom, addr, totalRate)
 					}
-				}
-				if totalRate.GT(sdk.OneDec()) {
-					return sdkerrors.Wrapf(
-						ErrInvalidTotalBudgetRate,
-						"total rate for source address %s must not exceed 1: %v", addr, totalRate)
 				}
 			}
-
 		}
 	}
 	return nil
@@ -117,3 +191,83 @@ func ValidateEpochBlocks(i interface{}) error {
 	}
 	return nil
 }
+
+// ValidateEpochMode validates epoch mode.
+func ValidateEpochMode(i interface{}) error {
+	mode, ok := i.(EpochMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if _, ok := EpochMode_name[int32(mode)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidEpoch, "unknown epoch mode %s", mode)
+	}
+	return nil
+}
+
+// ValidateEpochDuration validates epoch duration.
+func ValidateEpochDuration(i interface{}) error {
+	duration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if duration < 0 {
+		return sdkerrors.Wrapf(ErrInvalidEpoch, "epoch duration must not be negative: %s", duration)
+	}
+	return nil
+}
+
+// ValidateHistoryRetentionBlocks validates history retention blocks.
+func ValidateHistoryRetentionBlocks(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+// ValidateSourceProcessingModes validates source processing modes.
+func ValidateSourceProcessingModes(i interface{}) error {
+	modes, ok := i.([]SourceProcessingMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	sources := make(map[string]bool)
+	for _, mode := range modes {
+		if _, err := ResolveAddress(mode.SourceAddress); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid source address %s: %v", mode.SourceAddress, err)
+		}
+		if sources[resolvedAddressKey(mode.SourceAddress)] {
+			return sdkerrors.Wrapf(ErrInvalidProcessingMode, "duplicate source address %s", mode.SourceAddress)
+		}
+		if _, ok := ProcessingMode_name[int32(mode.Mode)]; !ok {
+			return sdkerrors.Wrapf(ErrInvalidProcessingMode, "unknown processing mode %s", mode.Mode)
+		}
+		sources[resolvedAddressKey(mode.SourceAddress)] = true
+	}
+	return nil
+}
+
+// ValidateSourceReserves validates source reserves.
+func ValidateSourceReserves(i interface{}) error {
+	reserves, ok := i.([]SourceReserve)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	sources := make(map[string]bool)
+	for _, reserve := range reserves {
+		if _, err := ResolveAddress(reserve.SourceAddress); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid source address %s: %v", reserve.SourceAddress, err)
+		}
+		if sources[resolvedAddressKey(reserve.SourceAddress)] {
+			return sdkerrors.Wrapf(ErrInvalidReserve, "duplicate source address %s", reserve.SourceAddress)
+		}
+		if reserve.Reserve.Empty() {
+			return sdkerrors.Wrapf(ErrInvalidReserve, "reserve of source address %s must not be empty", reserve.SourceAddress)
+		}
+		if err := reserve.Reserve.Validate(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidReserve, "invalid reserve of source address %s: %v", reserve.SourceAddress, err)
+		}
+		sources[resolvedAddressKey(reserve.SourceAddress)] = true
+	}
+	return nil
+}
@@ -1,10 +1,14 @@
 package types_test
 
 import (
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/stretchr/testify/require"
 
@@ -74,7 +78,11 @@ func TestParams(t *testing.T) {
 	defaultParams := types.DefaultParams()
 
 	paramsStr := `epoch_blocks: 1
-budgets: []
+source_processing_modes: []
+source_reserves: []
+epoch_mode: 0
+epoch_duration: 0s
+history_retention_blocks: 100000
 `
 	require.Equal(t, paramsStr, defaultParams.String())
 }
@@ -94,25 +102,588 @@ func TestValidateBudgets(t *testing.T) {
 
 	err = types.ValidateBudgets([]types.Budget{budgets[3], budgets[3]})
 	require.ErrorIs(t, err, types.ErrDuplicateBudgetName)
+
+	fixedBudget := types.Budget{
+		Name:               "test-fixed",
+		Type:               types.BudgetTypeFixedAmount,
+		Amount:             sdk.NewCoins(sdk.NewInt64Coin("uatom", 10000)),
+		SourceAddress:      sAddr1.String(),
+		DestinationAddress: dAddr2.String(),
+		StartTime:          types.MustParseRFC3339("2021-08-01T00:00:00Z"),
+		EndTime:            types.MustParseRFC3339("2021-08-03T00:00:00Z"),
+	}
+	err = types.ValidateBudgets([]types.Budget{budgets[0], fixedBudget})
+	require.NoError(t, err)
+
+	invalidBudget := fixedBudget
+	invalidBudget.Amount = nil
+	err = types.ValidateBudgets([]types.Budget{invalidBudget})
+	require.ErrorIs(t, err, types.ErrInvalidBudgetAmount)
+
+	invalidBudget = fixedBudget
+	invalidBudget.Rate = sdk.NewDecWithPrec(5, 1)
+	err = types.ValidateBudgets([]types.Budget{invalidBudget})
+	require.ErrorIs(t, err, types.ErrInvalidBudgetRate)
+
+	invalidBudget = budgets[0]
+	invalidBudget.Amount = sdk.NewCoins(sdk.NewInt64Coin("uatom", 10000))
+	err = types.ValidateBudgets([]types.Budget{invalidBudget})
+	require.ErrorIs(t, err, types.ErrInvalidBudgetAmount)
+
+	invalidBudget = budgets[0]
+	invalidBudget.Type = 2
+	err = types.ValidateBudgets([]types.Budget{invalidBudget})
+	require.ErrorIs(t, err, types.ErrInvalidBudgetType)
+}
+
+func TestValidateBudgetsDenomRates(t *testing.T) {
+	allowedBudget := budgets[0]
+	allowedBudget.Name = "test-allowed"
+	allowedBudget.AllowedDenoms = []string{"denom1"}
+	err := types.ValidateBudgets([]types.Budget{budgets[0], allowedBudget})
+	require.EqualError(t, err, "total rate of denom1 for source address "+sAddr1.String()+" must not exceed 1: 2.000000000000000000: invalid total rate of the budgets with the same source address")
+
+	deniedBudget := budgets[0]
+	deniedBudget.DeniedDenoms = []string{"denom1"}
+	denomRateBudget := budgets[0]
+	denomRateBudget.Name = "test-denom-rate"
+	denomRateBudget.Rate = sdk.ZeroDec()
+	denomRateBudget.DenomRates = []types.DenomRate{{Denom: "denom1", Rate: sdk.OneDec()}}
+	err = types.ValidateBudgets([]types.Budget{deniedBudget, denomRateBudget})
+	require.NoError(t, err)
+
+	invalidBudget := denomRateBudget
+	invalidBudget.DenomRates = []types.DenomRate{{Denom: "denom1", Rate: sdk.NewDecWithPrec(11, 1)}}
+	err = types.ValidateBudgets([]types.Budget{invalidBudget})
+	require.ErrorIs(t, err, types.ErrInvalidBudgetRate)
+
+	invalidBudget = denomRateBudget
+	invalidBudget.DeniedDenoms = []string{"denom1"}
+	err = types.ValidateBudgets([]types.Budget{invalidBudget})
+	require.ErrorIs(t, err, types.ErrInvalidBudgetDenoms)
+
+	invalidBudget = allowedBudget
+	invalidBudget.DeniedDenoms = []string{"denom1"}
+	err = types.ValidateBudgets([]types.Budget{invalidBudget})
+	require.ErrorIs(t, err, types.ErrInvalidBudgetDenoms)
+
+	invalidBudget = budgets[0]
+	invalidBudget.Rate = sdk.ZeroDec()
+	err = types.ValidateBudgets([]types.Budget{invalidBudget})
+	require.ErrorIs(t, err, types.ErrInvalidBudgetRate)
+}
+
+func TestValidateBudgetsCaps(t *testing.T) {
+	budget := budgets[0]
+	budget.LifetimeCap = sdk.NewCoins(sdk.NewInt64Coin("denom1", 1000))
+	budget.MaxEpochAmount = sdk.NewCoins(sdk.NewInt64Coin("denom1", 100))
+	budget.MinEpochAmount = sdk.NewCoins(sdk.NewInt64Coin("denom1", 10))
+	err := types.ValidateBudgets([]types.Budget{budget})
+	require.NoError(t, err)
+
+	invalidBudget := budget
+	invalidBudget.MinEpochAmount = sdk.NewCoins(sdk.NewInt64Coin("denom1", 200))
+	err = types.ValidateBudgets([]types.Budget{invalidBudget})
+	require.ErrorIs(t, err, types.ErrInvalidBudgetCap)
+
+	invalidBudget = budget
+	invalidBudget.DeniedDenoms = []string{"denom1"}
+	err = types.ValidateBudgets([]types.Budget{invalidBudget})
+	require.ErrorIs(t, err, types.ErrInvalidBudgetCap)
+
+	invalidBudget = budget
+	invalidBudget.LifetimeCap = sdk.Coins{sdk.Coin{Denom: "denom1", Amount: sdk.ZeroInt()}}
+	err = types.ValidateBudgets([]types.Budget{invalidBudget})
+	require.ErrorIs(t, err, types.ErrInvalidBudgetCap)
+
+	invalidBudget = budget
+	invalidBudget.Type = types.BudgetTypeFixedAmount
+	invalidBudget.Rate = sdk.Dec{}
+	invalidBudget.Amount = sdk.NewCoins(sdk.NewInt64Coin("denom1", 100))
+	err = types.ValidateBudgets([]types.Budget{invalidBudget})
+	require.ErrorIs(t, err, types.ErrInvalidBudgetCap)
+}
+
+func TestValidateBudgetsDestinations(t *testing.T) {
+	budget := budgets[0]
+	budget.DestinationAddress = ""
+	budget.Destinations = []types.BudgetDestination{
+		{Address: dAddr1.String(), Weight: sdk.NewDec(3)},
+		{Address: dAddr2.String(), Weight: sdk.NewDec(1)},
+	}
+	err := types.ValidateBudgets([]types.Budget{budget})
+	require.NoError(t, err)
+	require.True(t, budget.HasDestination(dAddr2.String()))
+	require.False(t, budget.HasDestination(sAddr1.String()))
+
+	invalidBudget := budget
+	invalidBudget.DestinationAddress = dAddr1.String()
+	err = types.ValidateBudgets([]types.Budget{invalidBudget})
+	require.ErrorIs(t, err, types.ErrInvalidBudgetDestinations)
+
+	invalidBudget = budget
+	invalidBudget.Destinations = []types.BudgetDestination{budget.Destinations[0], budget.Destinations[0]}
+	err = types.ValidateBudgets([]types.Budget{invalidBudget})
+	require.ErrorIs(t, err, types.ErrInvalidBudgetDestinations)
+
+	invalidBudget = budget
+	invalidBudget.Destinations = []types.BudgetDestination{{Address: dAddr1.String(), Weight: sdk.ZeroDec()}}
+	err = types.ValidateBudgets([]types.Budget{invalidBudget})
+	require.ErrorIs(t, err, types.ErrInvalidBudgetDestinations)
+
+	invalidBudget = budget
+	invalidBudget.Destinations = []types.BudgetDestination{{Address: "invalid", Weight: sdk.OneDec()}}
+	err = types.ValidateBudgets([]types.Budget{invalidBudget})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+}
+
+func TestValidateBudgetsCommunityPoolDestination(t *testing.T) {
+	budget := budgets[0]
+	budget.DestinationAddress = ""
+	communityPool := types.BudgetDestination{Weight: sdk.OneDec(), Type: types.DestinationTypeCommunityPool}
+	budget.Destinations = []types.BudgetDestination{
+		{Address: dAddr1.String(), Weight: sdk.OneDec()},
+		communityPool,
+	}
+	require.NoError(t, budget.Validate())
+
+	budget.Destinations = []types.BudgetDestination{communityPool, communityPool}
+	require.ErrorIs(t, budget.Validate(), types.ErrInvalidBudgetDestinations)
+
+	communityPool.Address = dAddr1.String()
+	budget.Destinations = []types.BudgetDestination{communityPool}
+	require.ErrorIs(t, budget.Validate(), types.ErrInvalidBudgetDestinations)
+
+	budget.Destinations = []types.BudgetDestination{communityPool, {Weight: sdk.OneDec(), Type: types.DestinationTypeBurn}}
+	require.ErrorIs(t, budget.Validate(), types.ErrInvalidBudgetDestinations)
+	communityPool.Address = ""
+	budget.Destinations = []types.BudgetDestination{communityPool, {Weight: sdk.OneDec(), Type: types.DestinationTypeBurn}}
+	require.NoError(t, budget.Validate())
+
+	budget.Destinations = []types.BudgetDestination{{Weight: sdk.OneDec(), Type: types.DestinationType(-1)}}
+	require.ErrorIs(t, budget.Validate(), types.ErrInvalidBudgetDestinations)
+}
+
+func TestValidateBudgetsStakingDestination(t *testing.T) {
+	valAddr1 := sdk.ValAddress(dAddr1).String()
+	valAddr2 := sdk.ValAddress(dAddr2).String()
+	budget := budgets[0]
+	budget.DestinationAddress = ""
+	staking := types.BudgetDestination{Weight: sdk.OneDec(), Type: types.DestinationTypeStaking, Validators: []string{valAddr1, valAddr2}}
+	budget.Destinations = []types.BudgetDestination{staking}
+	require.ErrorIs(t, budget.Validate(), types.ErrInvalidBudgetDestinations)
+
+	// a budget with a staking destination must collect a single denom
+	for _, tc := range []struct {
+		allowedDenoms []string
+		denomRates    []types.DenomRate
+		rate          sdk.Dec
+		valid         bool
+	}{
+		{[]string{"stake"}, nil, budget.Rate, true},
+		{[]string{"stake", "denom1"}, nil, budget.Rate, false},
+		{[]string{"stake", "denom1"}, []types.DenomRate{{Denom: "stake", Rate: budget.Rate}}, sdk.ZeroDec(), true},
+		{nil, []types.DenomRate{{Denom: "stake", Rate: budget.Rate}}, sdk.ZeroDec(), true},
+		{nil, []types.DenomRate{{Denom: "stake", Rate: budget.Rate}}, budget.Rate, false},
+	} {
+		budget.AllowedDenoms, budget.DenomRates, budget.Rate = tc.allowedDenoms, tc.denomRates, tc.rate
+		if tc.valid {
+			require.NoError(t, budget.Validate())
+		} else {
+			require.ErrorIs(t, budget.Validate(), types.ErrInvalidBudgetDestinations)
+		}
+	}
+
+	fixedAmount := budget
+	fixedAmount.Type = types.BudgetTypeFixedAmount
+	fixedAmount.Rate = sdk.ZeroDec()
+	fixedAmount.DenomRates = nil
+	fixedAmount.Amount = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000000), sdk.NewInt64Coin("denom1", 1000000))
+	require.ErrorIs(t, fixedAmount.Validate(), types.ErrInvalidBudgetDestinations)
+	fixedAmount.Amount = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000000))
+	require.NoError(t, fixedAmount.Validate())
+
+	budget.AllowedDenoms = []string{"stake"}
+	budget.DenomRates = nil
+	budget.Rate = budgets[0].Rate
+	require.NoError(t, budget.Validate())
+
+	staking.RewardsAddress = "module:distribution"
+	budget.Destinations = []types.BudgetDestination{staking}
+	require.NoError(t, budget.Validate())
+
+	staking.RewardsAddress = "invalid"
+	budget.Destinations = []types.BudgetDestination{staking}
+	require.ErrorIs(t, budget.Validate(), sdkerrors.ErrInvalidAddress)
+	staking.RewardsAddress = ""
+
+	for _, validators := range [][]string{nil, {valAddr1, valAddr1}} {
+		staking.Validators = validators
+		budget.Destinations = []types.BudgetDestination{staking}
+		require.ErrorIs(t, budget.Validate(), types.ErrInvalidBudgetDestinations)
+	}
+
+	staking.Validators = []string{dAddr1.String()}
+	budget.Destinations = []types.BudgetDestination{staking}
+	require.ErrorIs(t, budget.Validate(), sdkerrors.ErrInvalidAddress)
+
+	budget.Destinations = []types.BudgetDestination{{Address: dAddr1.String(), Weight: sdk.OneDec(), Validators: []string{valAddr1}}}
+	require.ErrorIs(t, budget.Validate(), types.ErrInvalidBudgetDestinations)
+}
+
+func TestValidateBudgetsIBCTransferDestination(t *testing.T) {
+	budget := budgets[0]
+	budget.DestinationAddress = ""
+	transfer := types.IBCTransfer{SourcePort: "transfer", SourceChannel: "channel-0", Receiver: "cosmos1receiver", Timeout: time.Hour}
+	budget.Destinations = []types.BudgetDestination{{Weight: sdk.OneDec(), Type: types.DestinationTypeIBCTransfer, IBCTransfer: &transfer}}
+	require.NoError(t, budget.Validate())
+
+	for _, malleate := range []func(transfer *types.IBCTransfer){
+		func(transfer *types.IBCTransfer) { transfer.SourcePort = "" },
+		func(transfer *types.IBCTransfer) { transfer.SourceChannel = "channel/0" },
+		func(transfer *types.IBCTransfer) { transfer.Receiver = " " },
+		func(transfer *types.IBCTransfer) { transfer.Timeout = 0 },
+		func(transfer *types.IBCTransfer) { transfer.OnFailure = types.IBCFailureAction(2) },
+	} {
+		invalid := transfer
+		malleate(&invalid)
+		budget.Destinations = []types.BudgetDestination{{Weight: sdk.OneDec(), Type: types.DestinationTypeIBCTransfer, IBCTransfer: &invalid}}
+		require.ErrorIs(t, budget.Validate(), types.ErrInvalidBudgetDestinations)
+	}
+
+	budget.Destinations = []types.BudgetDestination{{Weight: sdk.OneDec(), Type: types.DestinationTypeIBCTransfer}}
+	require.ErrorIs(t, budget.Validate(), types.ErrInvalidBudgetDestinations)
+
+	budget.Destinations = []types.BudgetDestination{{Address: dAddr1.String(), Weight: sdk.OneDec(), IBCTransfer: &transfer}}
+	require.ErrorIs(t, budget.Validate(), types.ErrInvalidBudgetDestinations)
+}
+
+func TestValidateBudgetsMetadata(t *testing.T) {
+	budget := budgets[0]
+	budget.Description = "liquidity farming rewards of the third quarter of 2021"
+	budget.Link = "https://forum.cosmos.network/t/liquidity-farming/1234"
+	budget.LinkHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	budget.Owner = "farming team <farming@example.com>"
+	budget.Tags = []string{"liquidity-farming", "incentives"}
+	require.NoError(t, budget.Validate())
+	require.True(t, budget.HasTag("incentives"))
+	require.False(t, budget.HasTag("grants"))
+
+	for _, tc := range []struct {
+		name      string
+		configure func(*types.Budget)
+	}{
+		{"long description", func(b *types.Budget) { b.Description = strings.Repeat("a", types.MaxBudgetDescriptionLength+1) }},
+		{"long owner", func(b *types.Budget) { b.Owner = strings.Repeat("a", types.MaxBudgetOwnerLength+1) }},
+		{"relative link", func(b *types.Budget) { b.Link = "forum/t/1234" }},
+		{"long link", func(b *types.Budget) { b.Link = "https://" + strings.Repeat("a", types.MaxBudgetLinkLength) }},
+		{"link hash without link", func(b *types.Budget) { b.Link = "" }},
+		{"short link hash", func(b *types.Budget) { b.LinkHash = "e3b0c442" }},
+		{"non-hex link hash", func(b *types.Budget) { b.LinkHash = strings.Repeat("z", 64) }},
+		{"too many tags", func(b *types.Budget) {
+			b.Tags = nil
+			for i := 0; i <= types.MaxBudgetTags; i++ {
+				b.Tags = append(b.Tags, fmt.Sprintf("tag%d", i))
+			}
+		}},
+		{"blank tag", func(b *types.Budget) { b.Tags = []string{""} }},
+		{"padded tag", func(b *types.Budget) { b.Tags = []string{" incentives"} }},
+		{"long tag", func(b *types.Budget) { b.Tags = []string{strings.Repeat("a", types.MaxBudgetTagLength+1)} }},
+		{"duplicate tags", func(b *types.Budget) { b.Tags = []string{"incentives", "incentives"} }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			invalidBudget := budget
+			tc.configure(&invalidBudget)
+			require.ErrorIs(t, invalidBudget.Validate(), types.ErrInvalidBudgetMetadata)
+		})
+	}
+}
+
+func TestDenomRate(t *testing.T) {
+	budget := types.Budget{
+		Rate:          sdk.NewDecWithPrec(5, 1),
+		DenomRates:    []types.DenomRate{{Denom: "denom2", Rate: sdk.NewDecWithPrec(1, 1)}},
+		AllowedDenoms: []string{"denom1", "denom2"},
+	}
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), budget.DenomRate("denom1"))
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), budget.DenomRate("denom2"))
+	require.True(t, budget.DenomRate("denom3").IsZero())
+
+	budget.AllowedDenoms = nil
+	budget.DeniedDenoms = []string{"denom1"}
+	require.True(t, budget.DenomRate("denom1").IsZero())
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), budget.DenomRate("denom3"))
 }
 
 func TestCollectibleBudgets(t *testing.T) {
-	collectibleBudgets := types.CollectibleBudgets([]types.Budget{budgets[0], budgets[1]}, types.MustParseRFC3339("2021-07-05T00:00:00Z"))
+	collectibleBudgets := types.CollectibleBudgets([]types.Budget{budgets[0], budgets[1]}, types.MustParseRFC3339("2021-07-05T00:00:00Z"), 1)
 	require.Len(t, collectibleBudgets, 1)
 
-	collectibleBudgets = types.CollectibleBudgets([]types.Budget{budgets[0], budgets[1], budgets[2]}, types.MustParseRFC3339("2021-07-05T00:00:00Z"))
+	collectibleBudgets = types.CollectibleBudgets([]types.Budget{budgets[0], budgets[1], budgets[2]}, types.MustParseRFC3339("2021-07-05T00:00:00Z"), 1)
 	require.Len(t, collectibleBudgets, 2)
 
-	collectibleBudgets = types.CollectibleBudgets([]types.Budget{budgets[4], budgets[5]}, types.MustParseRFC3339("2021-08-18T00:00:00Z"))
+	collectibleBudgets = types.CollectibleBudgets([]types.Budget{budgets[4], budgets[5]}, types.MustParseRFC3339("2021-08-18T00:00:00Z"), 1)
 	require.Len(t, collectibleBudgets, 1)
 
-	collectibleBudgets = types.CollectibleBudgets([]types.Budget{budgets[4], budgets[5]}, types.MustParseRFC3339("2021-08-19T00:00:00Z"))
+	collectibleBudgets = types.CollectibleBudgets([]types.Budget{budgets[4], budgets[5]}, types.MustParseRFC3339("2021-08-19T00:00:00Z"), 1)
 	require.Len(t, collectibleBudgets, 2)
 
-	collectibleBudgets = types.CollectibleBudgets([]types.Budget{budgets[4], budgets[5]}, types.MustParseRFC3339("2021-08-20T00:00:00Z"))
+	collectibleBudgets = types.CollectibleBudgets([]types.Budget{budgets[4], budgets[5]}, types.MustParseRFC3339("2021-08-20T00:00:00Z"), 1)
 	require.Len(t, collectibleBudgets, 1)
 }
 
+func TestCollectibleBudgetsHeights(t *testing.T) {
+	heightBudget := budgets[0]
+	heightBudget.StartTime = time.Time{}
+	heightBudget.EndTime = time.Time{}
+	heightBudget.StartHeight = 100
+	heightBudget.EndHeight = 200
+	require.NoError(t, heightBudget.Validate())
+
+	bothBudget := budgets[0]
+	bothBudget.StartHeight = 100
+	require.NoError(t, bothBudget.Validate())
+
+	blockTime := types.MustParseRFC3339("2021-08-02T00:00:00Z")
+	for _, tc := range []struct {
+		height      int64
+		blockTime   time.Time
+		collectible int
+	}{
+		{99, blockTime, 0},
+		{100, blockTime, 2},
+		{199, blockTime, 2},
+		{200, blockTime, 1},
+		{150, types.MustParseRFC3339("2021-08-03T00:00:00Z"), 1},
+	} {
+		require.Len(t, types.CollectibleBudgets([]types.Budget{heightBudget, bothBudget}, tc.blockTime, tc.height), tc.collectible)
+	}
+}
+
+func TestCollectibleBudgetsPaused(t *testing.T) {
+	pausedBudget := budgets[5]
+	pausedBudget.Paused = true
+	require.NoError(t, pausedBudget.Validate())
+
+	collectibleBudgets := types.CollectibleBudgets([]types.Budget{budgets[4], pausedBudget}, types.MustParseRFC3339("2021-08-19T00:00:00Z"), 1)
+	require.Len(t, collectibleBudgets, 1)
+	require.Equal(t, budgets[4].Name, collectibleBudgets[0].Name)
+
+	// a paused budget still counts toward the total rate
+	err := types.ValidateBudgets([]types.Budget{budgets[4], pausedBudget})
+	require.ErrorIs(t, err, types.ErrInvalidTotalBudgetRate)
+}
+
+func TestBudgetStatus(t *testing.T) {
+	heightBudget := budgets[0]
+	heightBudget.StartTime = time.Time{}
+	heightBudget.EndTime = time.Time{}
+	heightBudget.StartHeight = 100
+	heightBudget.EndHeight = 200
+
+	pausedBudget := budgets[0]
+	pausedBudget.Paused = true
+
+	blockTime := types.MustParseRFC3339("2021-08-02T00:00:00Z")
+	for _, tc := range []struct {
+		budget    types.Budget
+		height    int64
+		blockTime time.Time
+		status    types.BudgetStatus
+	}{
+		{heightBudget, 99, blockTime, types.BudgetStatusUpcoming},
+		{heightBudget, 100, blockTime, types.BudgetStatusActive},
+		{heightBudget, 200, blockTime, types.BudgetStatusExpired},
+		{budgets[0], 1, types.MustParseRFC3339("2021-07-01T00:00:00Z"), types.BudgetStatusUpcoming},
+		{budgets[0], 1, blockTime, types.BudgetStatusActive},
+		{budgets[0], 1, types.MustParseRFC3339("2021-08-03T00:00:00Z"), types.BudgetStatusExpired},
+		{pausedBudget, 1, blockTime, types.BudgetStatusActive},
+	} {
+		require.Equal(t, tc.status, tc.budget.Status(tc.blockTime, tc.height))
+	}
+}
+
+func TestValidateBudgetsTimeBounds(t *testing.T) {
+	budget := budgets[0]
+	budget.StartTime = types.MinBudgetTime
+	budget.EndTime = types.MaxBudgetTime
+	require.NoError(t, types.ValidateBudgets([]types.Budget{budget}))
+
+	// the times of a budget must be storable as protobuf timestamps
+	budget.StartTime = types.MustParseRFC3339("0000-01-01T00:00:00Z")
+	err := types.ValidateBudgets([]types.Budget{budget})
+	require.ErrorIs(t, err, types.ErrInvalidStartEndTime)
+}
+
+func TestValidateBudgetsHeights(t *testing.T) {
+	budget := budgets[0]
+	budget.StartTime = time.Time{}
+	budget.EndTime = time.Time{}
+	err := types.ValidateBudgets([]types.Budget{budget})
+	require.ErrorIs(t, err, types.ErrInvalidStartEndTime)
+
+	budget.EndHeight = 100
+	err = types.ValidateBudgets([]types.Budget{budget})
+	require.NoError(t, err)
+
+	invalidBudget := budget
+	invalidBudget.StartHeight = 100
+	err = types.ValidateBudgets([]types.Budget{invalidBudget})
+	require.ErrorIs(t, err, types.ErrInvalidStartEndHeight)
+
+	invalidBudget = budget
+	invalidBudget.StartHeight = -1
+	err = types.ValidateBudgets([]types.Budget{invalidBudget})
+	require.ErrorIs(t, err, types.ErrInvalidStartEndHeight)
+
+	// a budget without a time range overlaps the budgets of any time range
+	otherBudget := budgets[0]
+	otherBudget.Name = "other"
+	err = types.ValidateBudgets([]types.Budget{budget, otherBudget})
+	require.ErrorIs(t, err, types.ErrInvalidTotalBudgetRate)
+
+	// budgets with height ranges that do not overlap
+	otherBudget.StartHeight = 100
+	err = types.ValidateBudgets([]types.Budget{budget, otherBudget})
+	require.NoError(t, err)
+}
+
+func TestValidateBudgetsEpoch(t *testing.T) {
+	budget := budgets[0]
+	budget.EpochBlocks = 10
+	budget.EpochOffset = 9
+	require.NoError(t, budget.Validate())
+
+	// the offset is taken modulo the epoch length of the params
+	budget.EpochBlocks = 0
+	budget.EpochOffset = 15
+	require.NoError(t, budget.Validate())
+
+	invalidBudget := budget
+	invalidBudget.EpochBlocks = 10
+	invalidBudget.EpochOffset = 10
+	require.ErrorIs(t, invalidBudget.Validate(), types.ErrInvalidEpoch)
+
+	invalidBudget = budgets[0]
+	invalidBudget.EpochBlocks = 10
+	invalidBudget.Recurrence = &types.Recurrence{Type: types.RecurrenceTypeDaily}
+	require.ErrorIs(t, invalidBudget.Validate(), types.ErrInvalidEpoch)
+}
+
+func TestBudgetDue(t *testing.T) {
+	budget := budgets[0]
+	budget.EpochBlocks = 10
+	budget.EpochOffset = 3
+
+	for _, tc := range []struct {
+		height              int64
+		defaultEpochBlocks  uint32
+		lastCollectedHeight int64
+		due                 bool
+	}{
+		{13, 1, 0, true},
+		{14, 1, 0, false},
+		{23, 1, 13, true},
+		{20, 1, 13, false},
+		// the epoch length has been lengthened since the last collection
+		{13, 1, 3, true},
+		{13, 1, 7, false},
+		{23, 1, 7, true},
+	} {
+		require.Equal(t, tc.due, budget.Due(tc.height, tc.defaultEpochBlocks, tc.lastCollectedHeight), tc.height)
+	}
+
+	// a budget without its own epoch length uses the one of the params
+	budget.EpochBlocks = 0
+	require.True(t, budget.Due(3, 5, 0))
+	require.True(t, budget.Due(13, 5, 8))
+	require.False(t, budget.Due(13, 5, 10))
+	require.False(t, budget.Due(13, 0, 0))
+}
+
+func TestBudgetNextDueHeight(t *testing.T) {
+	budget := budgets[0]
+	budget.EpochBlocks = 10
+	budget.EpochOffset = 3
+
+	for _, tc := range []struct {
+		height              int64
+		defaultEpochBlocks  uint32
+		lastCollectedHeight int64
+		expected            int64
+	}{
+		{0, 1, 0, 3},
+		{3, 1, 0, 13},
+		{12, 1, 0, 13},
+		{13, 1, 13, 23},
+		// the epoch length has been lengthened since the last collection
+		{10, 1, 7, 23},
+	} {
+		next := budget.NextDueHeight(tc.height, tc.defaultEpochBlocks, tc.lastCollectedHeight)
+		require.Equal(t, tc.expected, next, tc.height)
+		require.True(t, budget.Due(next, tc.defaultEpochBlocks, tc.lastCollectedHeight), tc.height)
+	}
+
+	// a budget without its own epoch length uses the one of the params
+	budget.EpochBlocks = 0
+	require.Equal(t, int64(8), budget.NextDueHeight(5, 5, 3))
+	require.Equal(t, int64(18), budget.NextDueHeight(5, 5, 10))
+	require.Zero(t, budget.NextDueHeight(5, 0, 0))
+}
+
+func TestValidateEpochMode(t *testing.T) {
+	require.NoError(t, types.ValidateEpochMode(types.EpochModeBlocks))
+	require.NoError(t, types.ValidateEpochMode(types.EpochModeDuration))
+	require.ErrorIs(t, types.ValidateEpochMode(types.EpochMode(2)), types.ErrInvalidEpoch)
+	require.Error(t, types.ValidateEpochMode(int32(0)))
+
+	require.NoError(t, types.ValidateEpochDuration(time.Duration(0)))
+	require.NoError(t, types.ValidateEpochDuration(24*time.Hour))
+	require.ErrorIs(t, types.ValidateEpochDuration(-time.Second), types.ErrInvalidEpoch)
+	require.Error(t, types.ValidateEpochDuration(int64(0)))
+}
+
+func TestValidateSourceProcessingModes(t *testing.T) {
+	modes := []types.SourceProcessingMode{
+		{SourceAddress: sAddr1.String(), Mode: types.ProcessingModeSequential},
+		{SourceAddress: sAddr2.String(), Mode: types.ProcessingModeSharedSnapshot},
+	}
+	err := types.ValidateSourceProcessingModes(modes)
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.SourceProcessingModes = modes
+	require.Equal(t, types.ProcessingModeSequential, params.ProcessingMode(sAddr1.String()))
+	require.Equal(t, types.ProcessingModeSharedSnapshot, params.ProcessingMode(dAddr1.String()))
+
+	err = types.ValidateSourceProcessingModes([]types.SourceProcessingMode{modes[0], modes[0]})
+	require.ErrorIs(t, err, types.ErrInvalidProcessingMode)
+
+	err = types.ValidateSourceProcessingModes([]types.SourceProcessingMode{{SourceAddress: sAddr1.String(), Mode: 2}})
+	require.ErrorIs(t, err, types.ErrInvalidProcessingMode)
+
+	err = types.ValidateSourceProcessingModes([]types.SourceProcessingMode{{SourceAddress: "invalid"}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+
+	err = types.ValidateSourceProcessingModes(nil)
+	require.EqualError(t, err, "invalid parameter type: <nil>")
+}
+
+func TestSortBudgetsByPriority(t *testing.T) {
+	sorted := []types.Budget{
+		{Name: "a", Priority: 1},
+		{Name: "b"},
+		{Name: "c", Priority: 2},
+		{Name: "d", Priority: 1},
+	}
+	types.SortBudgetsByPriority(sorted)
+	var names []string
+	for _, budget := range sorted {
+		names = append(names, budget.Name)
+	}
+	require.Equal(t, []string{"c", "a", "d", "b"}, names)
+}
+
 func TestValidateEpochBlocks(t *testing.T) {
 	err := types.ValidateEpochBlocks(uint32(0))
 	require.NoError(t, err)
@@ -126,3 +697,33 @@ func TestValidateEpochBlocks(t *testing.T) {
 	err = types.ValidateEpochBlocks(10000000000000000)
 	require.EqualError(t, err, "invalid parameter type: int")
 }
+
+func TestValidateSourceReserves(t *testing.T) {
+	reserves := []types.SourceReserve{
+		{SourceAddress: sAddr1.String(), Reserve: sdk.NewCoins(sdk.NewInt64Coin("denom1", 100))},
+	}
+	err := types.ValidateSourceReserves(reserves)
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.SourceReserves = reserves
+	require.Equal(t, reserves[0].Reserve, params.SourceReserve(sAddr1.String()))
+	require.True(t, params.SourceReserve(sAddr2.String()).Empty())
+
+	err = types.ValidateSourceReserves([]types.SourceReserve{reserves[0], reserves[0]})
+	require.ErrorIs(t, err, types.ErrInvalidReserve)
+
+	err = types.ValidateSourceReserves([]types.SourceReserve{{SourceAddress: sAddr1.String()}})
+	require.ErrorIs(t, err, types.ErrInvalidReserve)
+
+	err = types.ValidateSourceReserves([]types.SourceReserve{
+		{SourceAddress: sAddr1.String(), Reserve: sdk.Coins{sdk.Coin{Denom: "denom1", Amount: sdk.ZeroInt()}}},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidReserve)
+
+	err = types.ValidateSourceReserves([]types.SourceReserve{{SourceAddress: "invalid", Reserve: reserves[0].Reserve}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+
+	err = types.ValidateSourceReserves(nil)
+	require.EqualError(t, err, "invalid parameter type: <nil>")
+}
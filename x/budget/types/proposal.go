@@ -0,0 +1,103 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeBudget defines the type for a BudgetProposal.
+	ProposalTypeBudget string = "Budget"
+)
+
+var _ govtypes.Content = &BudgetProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeBudget)
+	govtypes.RegisterProposalTypeCodec(&BudgetProposal{}, "budget/BudgetProposal")
+}
+
+// NewBudgetProposal creates a new BudgetProposal object.
+func NewBudgetProposal(
+	title string,
+	description string,
+	addBudgets []Budget,
+	updateBudgets []Budget,
+	deleteBudgetIDs []uint64,
+) *BudgetProposal {
+	return &BudgetProposal{
+		Title:           title,
+		Description:     description,
+		AddBudgets:      addBudgets,
+		UpdateBudgets:   updateBudgets,
+		DeleteBudgetIDs: deleteBudgetIDs,
+	}
+}
+
+// GetTitle returns the title of the budget proposal.
+func (p *BudgetProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the budget proposal.
+func (p *BudgetProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the budget proposal.
+func (p *BudgetProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the budget proposal.
+func (p *BudgetProposal) ProposalType() string { return ProposalTypeBudget }
+
+// ValidateBasic runs basic stateless validity checks. The total rates of the budgets are checked
+// against the budgets in the store when the proposal is executed.
+func (p *BudgetProposal) ValidateBasic() error {
+	if len(p.AddBudgets) == 0 && len(p.UpdateBudgets) == 0 && len(p.DeleteBudgetIDs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "proposal request must not be empty")
+	}
+
+	for _, budget := range p.AddBudgets {
+		if budget.ID != 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "budget %s to add must not have an id", budget.Name)
+		}
+		if err := budget.Validate(); err != nil {
+			return err
+		}
+	}
+
+	ids := make(map[uint64]bool)
+	for _, budget := range p.UpdateBudgets {
+		if budget.ID == 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "budget %s to update must have an id", budget.Name)
+		}
+		if ids[budget.ID] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate budget id %d", budget.ID)
+		}
+		if err := budget.Validate(); err != nil {
+			return err
+		}
+		ids[budget.ID] = true
+	}
+
+	for _, id := range p.DeleteBudgetIDs {
+		if id == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "budget id to delete must not be zero")
+		}
+		if ids[id] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate budget id %d", id)
+		}
+		ids[id] = true
+	}
+
+	return govtypes.ValidateAbstract(p)
+}
+
+// String implements the Stringer interface.
+func (p BudgetProposal) String() string {
+	return fmt.Sprintf(`Budget Proposal:
+  Title:             %s
+  Description:       %s
+  Add Budgets:       %v
+  Update Budgets:    %v
+  Delete Budget IDs: %v
+`, p.Title, p.Description, p.AddBudgets, p.UpdateBudgets, p.DeleteBudgetIDs)
+}
@@ -0,0 +1,584 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tendermint/budget/v1beta1/proposal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BudgetProposal defines a governance proposal that adds, updates, and deletes budgets.
+type BudgetProposal struct {
+	// title specifies the title of the proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	// description specifies the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	// add_budgets specifies the budgets to add, which are assigned new ids
+	AddBudgets []Budget `protobuf:"bytes,3,rep,name=add_budgets,json=addBudgets,proto3" json:"add_budgets" yaml:"add_budgets"`
+	// update_budgets specifies the budgets to replace, by their ids
+	UpdateBudgets []Budget `protobuf:"bytes,4,rep,name=update_budgets,json=updateBudgets,proto3" json:"update_budgets" yaml:"update_budgets"`
+	// delete_budget_ids specifies the ids of the budgets to delete
+	DeleteBudgetIDs []uint64 `protobuf:"varint,5,rep,packed,name=delete_budget_ids,json=deleteBudgetIds,proto3" json:"delete_budget_ids,omitempty" yaml:"delete_budget_ids"`
+}
+
+func (m *BudgetProposal) Reset()      { *m = BudgetProposal{} }
+func (*BudgetProposal) ProtoMessage() {}
+func (*BudgetProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_461daca1d50c00ed, []int{0}
+}
+func (m *BudgetProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BudgetProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BudgetProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BudgetProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BudgetProposal.Merge(m, src)
+}
+func (m *BudgetProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *BudgetProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_BudgetProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BudgetProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*BudgetProposal)(nil), "cosmos.budget.v1beta1.BudgetProposal")
+}
+
+func init() {
+	proto.RegisterFile("tendermint/budget/v1beta1/proposal.proto", fileDescriptor_461daca1d50c00ed)
+}
+
+var fileDescriptor_461daca1d50c00ed = []byte{
+	// 364 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xcf, 0x4a, 0xf3, 0x40,
+	0x10, 0xc0, 0x93, 0x2f, 0xed, 0xc7, 0xf7, 0x6d, 0xb5, 0xd5, 0x60, 0x25, 0x14, 0x9a, 0x94, 0x3d,
+	0x94, 0x80, 0x90, 0x50, 0xbd, 0x48, 0x8f, 0xa1, 0x1e, 0xbc, 0x49, 0x8e, 0xf5, 0x50, 0x92, 0xec,
+	0x12, 0x03, 0x49, 0x37, 0x64, 0xb7, 0x62, 0xdf, 0xc0, 0xa3, 0x47, 0xf1, 0xd4, 0xc7, 0xe9, 0xb1,
+	0x47, 0x4f, 0x41, 0xd2, 0x37, 0xe8, 0x13, 0x88, 0xbb, 0x69, 0x8d, 0xff, 0xc0, 0xdb, 0x30, 0xf3,
+	0x9b, 0xdf, 0xcc, 0xee, 0x00, 0x93, 0xe1, 0x29, 0xc2, 0x59, 0x12, 0x4d, 0x99, 0xed, 0xcf, 0x50,
+	0x88, 0x99, 0x7d, 0x3b, 0xf0, 0x31, 0xf3, 0x06, 0x76, 0x9a, 0x91, 0x94, 0x50, 0x2f, 0xb6, 0xd2,
+	0x8c, 0x30, 0xa2, 0xb6, 0x03, 0x42, 0x13, 0x42, 0x2d, 0x41, 0x59, 0x25, 0xd5, 0x39, 0x0a, 0x49,
+	0x48, 0x38, 0x61, 0xbf, 0x45, 0x02, 0xee, 0xf4, 0x7f, 0xd6, 0x96, 0xfd, 0x9c, 0x83, 0x4f, 0x0a,
+	0x68, 0x3a, 0x3c, 0x71, 0x55, 0x4e, 0x53, 0xfb, 0xa0, 0xce, 0x22, 0x16, 0x63, 0x4d, 0xee, 0xc9,
+	0xe6, 0x7f, 0xe7, 0x60, 0x93, 0x1b, 0x7b, 0x73, 0x2f, 0x89, 0x87, 0x90, 0xa7, 0xa1, 0x2b, 0xca,
+	0xea, 0x39, 0x68, 0x20, 0x4c, 0x83, 0x2c, 0x4a, 0x59, 0x44, 0xa6, 0xda, 0x1f, 0x4e, 0x1f, 0x6f,
+	0x72, 0x43, 0x15, 0x74, 0xa5, 0x08, 0xdd, 0x2a, 0xaa, 0x8e, 0x41, 0xc3, 0x43, 0x68, 0x22, 0x16,
+	0xa1, 0x9a, 0xd2, 0x53, 0xcc, 0xc6, 0x69, 0xd7, 0xfa, 0xf6, 0x7d, 0x96, 0xd8, 0xce, 0xe9, 0x2c,
+	0x73, 0x43, 0x7a, 0x97, 0x57, 0xfa, 0xa1, 0x0b, 0x3c, 0x84, 0x04, 0x46, 0xd5, 0x00, 0x34, 0x67,
+	0x29, 0xf2, 0x18, 0xde, 0xe9, 0x6b, 0xbf, 0xd1, 0x77, 0x4b, 0x7d, 0x5b, 0xe8, 0x3f, 0x2a, 0xa0,
+	0xbb, 0x2f, 0x12, 0xdb, 0x21, 0xd7, 0xe0, 0x10, 0xe1, 0x18, 0xef, 0x88, 0x49, 0x84, 0xa8, 0x56,
+	0xef, 0x29, 0x66, 0xcd, 0xb1, 0x8b, 0xdc, 0x68, 0x8d, 0x78, 0x51, 0xd0, 0x97, 0x23, 0xba, 0xc9,
+	0x0d, 0x6d, 0xfb, 0x27, 0x9f, 0xba, 0xa0, 0xdb, 0x42, 0x55, 0x18, 0xd1, 0xe1, 0xbf, 0xfb, 0x85,
+	0x21, 0x3d, 0x2e, 0x0c, 0xc9, 0xb9, 0x58, 0x16, 0xba, 0xbc, 0x2a, 0x74, 0xf9, 0xa5, 0xd0, 0xe5,
+	0x87, 0xb5, 0x2e, 0xad, 0xd6, 0xba, 0xf4, 0xbc, 0xd6, 0xa5, 0xf1, 0x49, 0x18, 0xb1, 0x9b, 0x99,
+	0x6f, 0x05, 0x24, 0xb1, 0xbf, 0x5e, 0xfa, 0x6e, 0x1b, 0xb0, 0x79, 0x8a, 0xa9, 0xff, 0x97, 0x9f,
+	0xfa, 0xec, 0x75, 0x00, 0xeb, 0x46, 0x80, 0x4e, 0x6b, 0x02, 0x00, 0x00,
+}
+
+func (m *BudgetProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BudgetProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BudgetProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeleteBudgetIDs) > 0 {
+		dAtA2 := make([]byte, len(m.DeleteBudgetIDs)*10)
+		var j1 int
+		for _, num := range m.DeleteBudgetIDs {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintProposal(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.UpdateBudgets) > 0 {
+		for iNdEx := len(m.UpdateBudgets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpdateBudgets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AddBudgets) > 0 {
+		for iNdEx := len(m.AddBudgets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddBudgets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BudgetProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if len(m.AddBudgets) > 0 {
+		for _, e := range m.AddBudgets {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	if len(m.UpdateBudgets) > 0 {
+		for _, e := range m.UpdateBudgets {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	if len(m.DeleteBudgetIDs) > 0 {
+		l = 0
+		for _, e := range m.DeleteBudgetIDs {
+			l += sovProposal(uint64(e))
+		}
+		n += 1 + sovProposal(uint64(l)) + l
+	}
+	return n
+}
+
+func sovProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposal(x uint64) (n int) {
+	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BudgetProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BudgetProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BudgetProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddBudgets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddBudgets = append(m.AddBudgets, Budget{})
+			if err := m.AddBudgets[len(m.AddBudgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateBudgets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateBudgets = append(m.UpdateBudgets, Budget{})
+			if err := m.UpdateBudgets[len(m.UpdateBudgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProposal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DeleteBudgetIDs = append(m.DeleteBudgetIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProposal
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProposal
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProposal
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DeleteBudgetIDs) == 0 {
+					m.DeleteBudgetIDs = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProposal
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DeleteBudgetIDs = append(m.DeleteBudgetIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteBudgetIDs", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProposal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProposal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProposal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProposal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProposal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProposal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProposal = fmt.Errorf("proto: unexpected end of group")
+)
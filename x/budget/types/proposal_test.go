@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/budget/x/budget/types"
+)
+
+func TestBudgetProposalValidateBasic(t *testing.T) {
+	withID := func(budget types.Budget, id uint64) types.Budget {
+		budget.ID = id
+		return budget
+	}
+
+	testCases := []struct {
+		name        string
+		proposal    *types.BudgetProposal
+		expectedErr string
+	}{
+		{
+			"add, update and delete budgets",
+			types.NewBudgetProposal("title", "description",
+				[]types.Budget{budgets[0]}, []types.Budget{withID(budgets[1], 1)}, []uint64{2}),
+			"",
+		},
+		{
+			"empty proposal",
+			types.NewBudgetProposal("title", "description", nil, nil, nil),
+			"proposal request must not be empty: invalid request",
+		},
+		{
+			"budget to add with an id",
+			types.NewBudgetProposal("title", "description", []types.Budget{withID(budgets[0], 1)}, nil, nil),
+			"budget test to add must not have an id: invalid request",
+		},
+		{
+			"budget to update without an id",
+			types.NewBudgetProposal("title", "description", nil, []types.Budget{budgets[0]}, nil),
+			"budget test to update must have an id: invalid request",
+		},
+		{
+			"duplicate budgets to update",
+			types.NewBudgetProposal("title", "description", nil,
+				[]types.Budget{withID(budgets[0], 1), withID(budgets[1], 1)}, nil),
+			"duplicate budget id 1: invalid request",
+		},
+		{
+			"budget to both update and delete",
+			types.NewBudgetProposal("title", "description", nil, []types.Budget{withID(budgets[0], 1)}, []uint64{1}),
+			"duplicate budget id 1: invalid request",
+		},
+		{
+			"zero budget id to delete",
+			types.NewBudgetProposal("title", "description", nil, nil, []uint64{0}),
+			"budget id to delete must not be zero: invalid request",
+		},
+		{
+			"empty title",
+			types.NewBudgetProposal("", "description", nil, nil, []uint64{1}),
+			"proposal title cannot be blank: invalid proposal content",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, types.RouterKey, tc.proposal.ProposalRoute())
+			require.Equal(t, types.ProposalTypeBudget, tc.proposal.ProposalType())
+			err := tc.proposal.ValidateBasic()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
@@ -9,25 +9,30 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "github.com/regen-network/cosmos-proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -35,6 +40,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BudgetOrder enumerates the available orders of the budgets returned by the Budgets query.
+type BudgetOrder int32
+
+const (
+	// BUDGET_ORDER_ID orders the budgets by their ids.
+	BudgetOrderID BudgetOrder = 0
+	// BUDGET_ORDER_NAME orders the budgets by their names.
+	BudgetOrderName BudgetOrder = 1
+	// BUDGET_ORDER_START_TIME orders the budgets by their start times.
+	BudgetOrderStartTime BudgetOrder = 2
+	// BUDGET_ORDER_TOTAL_COLLECTED orders the budgets by their total collected coins of order_denom.
+	BudgetOrderTotalCollected BudgetOrder = 3
+)
+
+var BudgetOrder_name = map[int32]string{
+	0: "BUDGET_ORDER_ID",
+	1: "BUDGET_ORDER_NAME",
+	2: "BUDGET_ORDER_START_TIME",
+	3: "BUDGET_ORDER_TOTAL_COLLECTED",
+}
+
+var BudgetOrder_value = map[string]int32{
+	"BUDGET_ORDER_ID":              0,
+	"BUDGET_ORDER_NAME":            1,
+	"BUDGET_ORDER_START_TIME":      2,
+	"BUDGET_ORDER_TOTAL_COLLECTED": 3,
+}
+
+func (x BudgetOrder) String() string {
+	return proto.EnumName(BudgetOrder_name, int32(x))
+}
+
+func (BudgetOrder) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_60151551cef977ce, []int{0}
+}
+
 // AddressType enumerates the available types of a address.
 type AddressType int32
 
@@ -60,7 +101,7 @@ func (x AddressType) String() string {
 }
 
 func (AddressType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_60151551cef977ce, []int{0}
+	return fileDescriptor_60151551cef977ce, []int{1}
 }
 
 // QueryParamsRequest is the request type for the Query/Params RPC method.
@@ -150,6 +191,19 @@ type QueryBudgetsRequest struct {
 	Name               string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	SourceAddress      string `protobuf:"bytes,2,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty"`
 	DestinationAddress string `protobuf:"bytes,3,opt,name=destination_address,json=destinationAddress,proto3" json:"destination_address,omitempty"`
+	// collectible filters the budgets that are collectible at the current block time and height
+	Collectible bool `protobuf:"varint,4,opt,name=collectible,proto3" json:"collectible,omitempty"`
+	// tag filters the budgets that have the tag
+	Tag string `protobuf:"bytes,5,opt,name=tag,proto3" json:"tag,omitempty"`
+	// status filters the budgets that have the lifecycle status, the archived budgets are returned only if it is
+	// BUDGET_STATUS_EXPIRED or BUDGET_STATUS_REMOVED
+	Status BudgetStatus `protobuf:"varint,6,opt,name=status,proto3,enum=cosmos.budget.v1beta1.BudgetStatus" json:"status,omitempty"`
+	// pagination defines an optional pagination for the request
+	Pagination *query.PageRequest `protobuf:"bytes,7,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// order_by specifies the order of the budgets, which is reversed by pagination.reverse
+	OrderBy BudgetOrder `protobuf:"varint,8,opt,name=order_by,json=orderBy,proto3,enum=cosmos.budget.v1beta1.BudgetOrder" json:"order_by,omitempty"`
+	// order_denom specifies the denom of the total collected coins for BUDGET_ORDER_TOTAL_COLLECTED
+	OrderDenom string `protobuf:"bytes,9,opt,name=order_denom,json=orderDenom,proto3" json:"order_denom,omitempty"`
 }
 
 func (m *QueryBudgetsRequest) Reset()         { *m = QueryBudgetsRequest{} }
@@ -206,9 +260,52 @@ func (m *QueryBudgetsRequest) GetDestinationAddress() string {
 	return ""
 }
 
+func (m *QueryBudgetsRequest) GetCollectible() bool {
+	if m != nil {
+		return m.Collectible
+	}
+	return false
+}
+
+func (m *QueryBudgetsRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *QueryBudgetsRequest) GetStatus() BudgetStatus {
+	if m != nil {
+		return m.Status
+	}
+	return BudgetStatusUnspecified
+}
+
+func (m *QueryBudgetsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryBudgetsRequest) GetOrderBy() BudgetOrder {
+	if m != nil {
+		return m.OrderBy
+	}
+	return BudgetOrderID
+}
+
+func (m *QueryBudgetsRequest) GetOrderDenom() string {
+	if m != nil {
+		return m.OrderDenom
+	}
+	return ""
+}
+
 // QueryBudgetsResponse is the response type for the Query/Budgets RPC method.
 type QueryBudgetsResponse struct {
-	Budgets []BudgetResponse `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets"`
+	Budgets    []BudgetResponse    `protobuf:"bytes,1,rep,name=budgets,proto3" json:"budgets"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryBudgetsResponse) Reset()         { *m = QueryBudgetsResponse{} }
@@ -251,9 +348,22 @@ func (m *QueryBudgetsResponse) GetBudgets() []BudgetResponse {
 	return nil
 }
 
+func (m *QueryBudgetsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type BudgetResponse struct {
 	Budget              Budget                                   `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget"`
 	TotalCollectedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_collected_coins,json=totalCollectedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_collected_coins" yaml:"total_collected_coins"`
+	// exhausted specifies whether the total collected coins reached the lifetime cap of the budget
+	Exhausted bool `protobuf:"varint,3,opt,name=exhausted,proto3" json:"exhausted,omitempty"`
+	// destination_collected_coins specifies the total collected coins for each destination of the budget
+	DestinationCollectedCoins []DestinationCollectedCoins `protobuf:"bytes,4,rep,name=destination_collected_coins,json=destinationCollectedCoins,proto3" json:"destination_collected_coins" yaml:"destination_collected_coins"`
+	// status specifies the lifecycle status of the budget
+	Status BudgetStatus `protobuf:"varint,5,opt,name=status,proto3,enum=cosmos.budget.v1beta1.BudgetStatus" json:"status,omitempty"`
 }
 
 func (m *BudgetResponse) Reset()         { *m = BudgetResponse{} }
@@ -303,6 +413,361 @@ func (m *BudgetResponse) GetTotalCollectedCoins() github_com_cosmos_cosmos_sdk_t
 	return nil
 }
 
+func (m *BudgetResponse) GetExhausted() bool {
+	if m != nil {
+		return m.Exhausted
+	}
+	return false
+}
+
+func (m *BudgetResponse) GetDestinationCollectedCoins() []DestinationCollectedCoins {
+	if m != nil {
+		return m.DestinationCollectedCoins
+	}
+	return nil
+}
+
+func (m *BudgetResponse) GetStatus() BudgetStatus {
+	if m != nil {
+		return m.Status
+	}
+	return BudgetStatusUnspecified
+}
+
+// QueryCollectionHistoryRequest is the request type for the Query/CollectionHistory RPC method.
+type QueryCollectionHistoryRequest struct {
+	BudgetId   uint64             `protobuf:"varint,1,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCollectionHistoryRequest) Reset()         { *m = QueryCollectionHistoryRequest{} }
+func (m *QueryCollectionHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCollectionHistoryRequest) ProtoMessage()    {}
+func (*QueryCollectionHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60151551cef977ce, []int{5}
+}
+func (m *QueryCollectionHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCollectionHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCollectionHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCollectionHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollectionHistoryRequest.Merge(m, src)
+}
+func (m *QueryCollectionHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCollectionHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollectionHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCollectionHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryCollectionHistoryRequest) GetBudgetId() uint64 {
+	if m != nil {
+		return m.BudgetId
+	}
+	return 0
+}
+
+func (m *QueryCollectionHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryCollectionHistoryResponse is the response type for the Query/CollectionHistory RPC method.
+type QueryCollectionHistoryResponse struct {
+	// records specifies the collection records of the budget in order of height
+	Records    []CollectionRecord  `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCollectionHistoryResponse) Reset()         { *m = QueryCollectionHistoryResponse{} }
+func (m *QueryCollectionHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCollectionHistoryResponse) ProtoMessage()    {}
+func (*QueryCollectionHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60151551cef977ce, []int{6}
+}
+func (m *QueryCollectionHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCollectionHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCollectionHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCollectionHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollectionHistoryResponse.Merge(m, src)
+}
+func (m *QueryCollectionHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCollectionHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollectionHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCollectionHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryCollectionHistoryResponse) GetRecords() []CollectionRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *QueryCollectionHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryProjectionsRequest is the request type for the Query/Projections RPC method.
+type QueryProjectionsRequest struct {
+	// block_interval specifies the expected time between blocks to estimate the times of the collections,
+	// which is 6 seconds if it is not set
+	BlockInterval time.Duration `protobuf:"bytes,1,opt,name=block_interval,json=blockInterval,proto3,stdduration" json:"block_interval"`
+}
+
+func (m *QueryProjectionsRequest) Reset()         { *m = QueryProjectionsRequest{} }
+func (m *QueryProjectionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectionsRequest) ProtoMessage()    {}
+func (*QueryProjectionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60151551cef977ce, []int{7}
+}
+func (m *QueryProjectionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectionsRequest.Merge(m, src)
+}
+func (m *QueryProjectionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectionsRequest proto.InternalMessageInfo
+
+func (m *QueryProjectionsRequest) GetBlockInterval() time.Duration {
+	if m != nil {
+		return m.BlockInterval
+	}
+	return 0
+}
+
+// QueryProjectionsResponse is the response type for the Query/Projections RPC method.
+type QueryProjectionsResponse struct {
+	Projections []BudgetProjection `protobuf:"bytes,1,rep,name=projections,proto3" json:"projections"`
+}
+
+func (m *QueryProjectionsResponse) Reset()         { *m = QueryProjectionsResponse{} }
+func (m *QueryProjectionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectionsResponse) ProtoMessage()    {}
+func (*QueryProjectionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60151551cef977ce, []int{8}
+}
+func (m *QueryProjectionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectionsResponse.Merge(m, src)
+}
+func (m *QueryProjectionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectionsResponse proto.InternalMessageInfo
+
+func (m *QueryProjectionsResponse) GetProjections() []BudgetProjection {
+	if m != nil {
+		return m.Projections
+	}
+	return nil
+}
+
+// BudgetProjection defines the next collection of a budget, as it would be collected if the state does not
+// change until then.
+type BudgetProjection struct {
+	BudgetID uint64 `protobuf:"varint,1,opt,name=budget_id,json=budgetId,proto3" json:"budget_id,omitempty" yaml:"budget_id"`
+	Name     string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// next_collection_height specifies the height of the next block at which the budget is due to collect, or 0 if
+	// the budget is not due to collect with the current params
+	NextCollectionHeight int64 `protobuf:"varint,3,opt,name=next_collection_height,json=nextCollectionHeight,proto3" json:"next_collection_height,omitempty" yaml:"next_collection_height"`
+	// estimated_time specifies the estimated time of the block of next_collection_height
+	EstimatedTime time.Time `protobuf:"bytes,4,opt,name=estimated_time,json=estimatedTime,proto3,stdtime" json:"estimated_time" yaml:"estimated_time"`
+	// source_address specifies the resolved bech32-encoded address of the source
+	SourceAddress string `protobuf:"bytes,5,opt,name=source_address,json=sourceAddress,proto3" json:"source_address,omitempty" yaml:"source_address"`
+	// source_balances specifies the current balances of the source address
+	SourceBalances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=source_balances,json=sourceBalances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"source_balances" yaml:"source_balances"`
+	// collected_coins specifies the coins the budget would collect at the next collection
+	CollectedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=collected_coins,json=collectedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"collected_coins" yaml:"collected_coins"`
+	// destination_coins specifies the coins each destination of the budget would receive at the next collection
+	DestinationCoins []DestinationCollectedCoins `protobuf:"bytes,8,rep,name=destination_coins,json=destinationCoins,proto3" json:"destination_coins" yaml:"destination_coins"`
+}
+
+func (m *BudgetProjection) Reset()         { *m = BudgetProjection{} }
+func (m *BudgetProjection) String() string { return proto.CompactTextString(m) }
+func (*BudgetProjection) ProtoMessage()    {}
+func (*BudgetProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60151551cef977ce, []int{9}
+}
+func (m *BudgetProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BudgetProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BudgetProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BudgetProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BudgetProjection.Merge(m, src)
+}
+func (m *BudgetProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *BudgetProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_BudgetProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BudgetProjection proto.InternalMessageInfo
+
+// QueryTotalBurnedCoinsRequest is the request type for the Query/TotalBurnedCoins RPC method.
+type QueryTotalBurnedCoinsRequest struct {
+}
+
+func (m *QueryTotalBurnedCoinsRequest) Reset()         { *m = QueryTotalBurnedCoinsRequest{} }
+func (m *QueryTotalBurnedCoinsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalBurnedCoinsRequest) ProtoMessage()    {}
+func (*QueryTotalBurnedCoinsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60151551cef977ce, []int{10}
+}
+func (m *QueryTotalBurnedCoinsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalBurnedCoinsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalBurnedCoinsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalBurnedCoinsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalBurnedCoinsRequest.Merge(m, src)
+}
+func (m *QueryTotalBurnedCoinsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalBurnedCoinsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalBurnedCoinsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalBurnedCoinsRequest proto.InternalMessageInfo
+
+// QueryTotalBurnedCoinsResponse is the response type for the Query/TotalBurnedCoins RPC method.
+type QueryTotalBurnedCoinsResponse struct {
+	TotalBurnedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total_burned_coins,json=totalBurnedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_burned_coins" yaml:"total_burned_coins"`
+}
+
+func (m *QueryTotalBurnedCoinsResponse) Reset()         { *m = QueryTotalBurnedCoinsResponse{} }
+func (m *QueryTotalBurnedCoinsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalBurnedCoinsResponse) ProtoMessage()    {}
+func (*QueryTotalBurnedCoinsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60151551cef977ce, []int{11}
+}
+func (m *QueryTotalBurnedCoinsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalBurnedCoinsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalBurnedCoinsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalBurnedCoinsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalBurnedCoinsResponse.Merge(m, src)
+}
+func (m *QueryTotalBurnedCoinsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalBurnedCoinsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalBurnedCoinsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalBurnedCoinsResponse proto.InternalMessageInfo
+
+func (m *QueryTotalBurnedCoinsResponse) GetTotalBurnedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalBurnedCoins
+	}
+	return nil
+}
+
 // QueryAddressesRequest is the request type for the Query/Addresses RPC method.
 type QueryAddressesRequest struct {
 	// The Address Type, default 0 for ADDRESS_TYPE_32_BYTES or 1 for ADDRESS_TYPE_20_BYTES
@@ -317,7 +782,7 @@ func (m *QueryAddressesRequest) Reset()         { *m = QueryAddressesRequest{} }
 func (m *QueryAddressesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAddressesRequest) ProtoMessage()    {}
 func (*QueryAddressesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60151551cef977ce, []int{5}
+	return fileDescriptor_60151551cef977ce, []int{12}
 }
 func (m *QueryAddressesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -376,7 +841,7 @@ func (m *QueryAddressesResponse) Reset()         { *m = QueryAddressesResponse{}
 func (m *QueryAddressesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAddressesResponse) ProtoMessage()    {}
 func (*QueryAddressesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_60151551cef977ce, []int{6}
+	return fileDescriptor_60151551cef977ce, []int{13}
 }
 func (m *QueryAddressesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -413,12 +878,20 @@ func (m *QueryAddressesResponse) GetAddress() string {
 }
 
 func init() {
+	proto.RegisterEnum("cosmos.budget.v1beta1.BudgetOrder", BudgetOrder_name, BudgetOrder_value)
 	proto.RegisterEnum("cosmos.budget.v1beta1.AddressType", AddressType_name, AddressType_value)
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.budget.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.budget.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryBudgetsRequest)(nil), "cosmos.budget.v1beta1.QueryBudgetsRequest")
 	proto.RegisterType((*QueryBudgetsResponse)(nil), "cosmos.budget.v1beta1.QueryBudgetsResponse")
 	proto.RegisterType((*BudgetResponse)(nil), "cosmos.budget.v1beta1.BudgetResponse")
+	proto.RegisterType((*QueryCollectionHistoryRequest)(nil), "cosmos.budget.v1beta1.QueryCollectionHistoryRequest")
+	proto.RegisterType((*QueryCollectionHistoryResponse)(nil), "cosmos.budget.v1beta1.QueryCollectionHistoryResponse")
+	proto.RegisterType((*QueryProjectionsRequest)(nil), "cosmos.budget.v1beta1.QueryProjectionsRequest")
+	proto.RegisterType((*QueryProjectionsResponse)(nil), "cosmos.budget.v1beta1.QueryProjectionsResponse")
+	proto.RegisterType((*BudgetProjection)(nil), "cosmos.budget.v1beta1.BudgetProjection")
+	proto.RegisterType((*QueryTotalBurnedCoinsRequest)(nil), "cosmos.budget.v1beta1.QueryTotalBurnedCoinsRequest")
+	proto.RegisterType((*QueryTotalBurnedCoinsResponse)(nil), "cosmos.budget.v1beta1.QueryTotalBurnedCoinsResponse")
 	proto.RegisterType((*QueryAddressesRequest)(nil), "cosmos.budget.v1beta1.QueryAddressesRequest")
 	proto.RegisterType((*QueryAddressesResponse)(nil), "cosmos.budget.v1beta1.QueryAddressesResponse")
 }
@@ -428,68 +901,122 @@ func init() {
 }
 
 var fileDescriptor_60151551cef977ce = []byte{
-	// 968 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x26, 0x21, 0x51, 0xc7, 0x22, 0xaa, 0x26, 0x49, 0x95, 0xae, 0xda, 0xf5, 0x6a, 0xa4,
-	0x40, 0x9a, 0x26, 0x1e, 0x67, 0x83, 0x7a, 0x30, 0x27, 0xbb, 0x35, 0x02, 0x0e, 0x28, 0x38, 0xb9,
-	0x14, 0x54, 0xad, 0xc6, 0xbb, 0x53, 0x7b, 0xdb, 0xdd, 0x99, 0xed, 0xce, 0x6c, 0x8a, 0x55, 0x15,
-	0x41, 0xc5, 0x01, 0x71, 0x82, 0x70, 0x44, 0xc0, 0x01, 0x89, 0x03, 0xe2, 0xc6, 0x5f, 0xe0, 0xd0,
-	0x63, 0x25, 0x2e, 0x9c, 0x0a, 0x4a, 0xe0, 0x8c, 0xc4, 0x2f, 0x40, 0x3b, 0x33, 0x6b, 0x9c, 0xb8,
-	0x0e, 0x91, 0x38, 0x79, 0xfc, 0xe6, 0xfb, 0xbe, 0x79, 0xef, 0x9b, 0xf7, 0x66, 0xc1, 0x9a, 0xa4,
-	0x2c, 0xa4, 0x59, 0x12, 0x31, 0x89, 0x7b, 0x79, 0xd8, 0xa7, 0x12, 0x1f, 0x6c, 0xf7, 0xa8, 0x24,
-	0xdb, 0xf8, 0x41, 0x4e, 0xb3, 0x61, 0x3d, 0xcd, 0xb8, 0xe4, 0x70, 0x25, 0xe0, 0x22, 0xe1, 0xa2,
-	0xae, 0x21, 0x75, 0x03, 0xb1, 0x5f, 0x99, 0xce, 0x36, 0x48, 0x45, 0xb7, 0x37, 0x34, 0x1d, 0xf7,
-	0x88, 0xa0, 0x5a, 0x77, 0x84, 0x4b, 0x49, 0x3f, 0x62, 0x44, 0x46, 0x9c, 0x19, 0xec, 0x72, 0x9f,
-	0xf7, 0xb9, 0x5a, 0xe2, 0x62, 0x65, 0xa2, 0x97, 0xfb, 0x9c, 0xf7, 0x63, 0x8a, 0xd5, 0xbf, 0x5e,
-	0x7e, 0x17, 0x13, 0x66, 0x72, 0xb3, 0xaf, 0x98, 0x2d, 0x92, 0x46, 0x98, 0x30, 0xc6, 0xa5, 0x52,
-	0x13, 0x25, 0x51, 0x1f, 0xed, 0x6b, 0x45, 0x53, 0x86, 0xde, 0x72, 0xc6, 0xb3, 0x2a, 0xf3, 0x09,
-	0x78, 0xc4, 0xce, 0x25, 0xac, 0x7f, 0x82, 0xad, 0x3e, 0x65, 0x5b, 0x3c, 0xa5, 0x8c, 0xa4, 0xd1,
-	0x81, 0x87, 0x79, 0xaa, 0x30, 0x93, 0x78, 0xb4, 0x0c, 0xe0, 0xbb, 0x45, 0xe5, 0xbb, 0x24, 0x23,
-	0x89, 0xe8, 0xd2, 0x07, 0x39, 0x15, 0x12, 0x75, 0xc1, 0xd2, 0x89, 0xa8, 0x48, 0x39, 0x13, 0x14,
-	0xbe, 0x0e, 0xe6, 0x53, 0x15, 0x59, 0xb5, 0x5c, 0x6b, 0xbd, 0xea, 0x5d, 0xad, 0xbf, 0xf0, 0x02,
-	0xea, 0x9a, 0xd6, 0x9e, 0x7b, 0xfa, 0xbc, 0x56, 0xe9, 0x1a, 0x0a, 0xfa, 0xd8, 0x32, 0xa2, 0x6d,
-	0x05, 0x2e, 0xcf, 0x82, 0x10, 0xcc, 0x31, 0x92, 0x50, 0x25, 0x79, 0xa1, 0xab, 0xd6, 0x70, 0x0d,
-	0x2c, 0x0a, 0x9e, 0x67, 0x01, 0xf5, 0x49, 0x18, 0x66, 0x54, 0x88, 0xd5, 0x19, 0xb5, 0xfb, 0xb2,
-	0x8e, 0xb6, 0x74, 0x10, 0x62, 0xb0, 0x14, 0x52, 0x21, 0xcd, 0x4d, 0x8d, 0xb0, 0xb3, 0x0a, 0x0b,
-	0xc7, 0xb6, 0x0c, 0x01, 0xdd, 0x01, 0xcb, 0x27, 0x53, 0x30, 0x85, 0x75, 0xc0, 0x82, 0x2e, 0xa1,
-	0xa8, 0x6c, 0x76, 0xbd, 0xea, 0xad, 0x4d, 0xa9, 0x4c, 0x13, 0x4b, 0x9e, 0xa9, 0xb0, 0xe4, 0xa2,
-	0xbf, 0x2c, 0xb0, 0x78, 0x12, 0x51, 0x58, 0xa6, 0x77, 0xff, 0xc3, 0x32, 0x4d, 0x2b, 0x2d, 0xd3,
-	0x9b, 0xf0, 0x5b, 0x0b, 0xac, 0x48, 0x2e, 0x49, 0xec, 0x07, 0x3c, 0x8e, 0x69, 0x20, 0x69, 0xe8,
-	0x17, 0x9d, 0x50, 0xd8, 0x51, 0x64, 0x79, 0x79, 0x24, 0x46, 0x04, 0x1d, 0x49, 0xdd, 0xe4, 0x11,
-	0x6b, 0xef, 0x16, 0x42, 0x7f, 0x3f, 0xaf, 0x5d, 0x19, 0x92, 0x24, 0x6e, 0xa2, 0x17, 0xaa, 0xa0,
-	0x1f, 0x7e, 0xab, 0xad, 0xf7, 0x23, 0x39, 0xc8, 0x7b, 0xf5, 0x80, 0x27, 0xa6, 0x0d, 0xcd, 0xcf,
-	0x96, 0x08, 0xef, 0x63, 0x39, 0x4c, 0xa9, 0x50, 0x82, 0xa2, 0xbb, 0xa4, 0x34, 0x6e, 0x96, 0x12,
-	0x2a, 0x88, 0x3e, 0xb1, 0xc0, 0x8a, 0x72, 0xd4, 0x38, 0x4c, 0x47, 0xd7, 0x7a, 0x03, 0xcc, 0x15,
-	0x6c, 0x55, 0xf6, 0xa2, 0x87, 0xa6, 0x94, 0x6d, 0x68, 0xfb, 0xc3, 0x94, 0x76, 0x15, 0x1e, 0xd6,
-	0x40, 0x35, 0xe1, 0x61, 0x1e, 0x53, 0x5f, 0x75, 0x85, 0xbe, 0x77, 0xa0, 0x43, 0xef, 0x14, 0xbd,
-	0x51, 0xf6, 0xcb, 0xec, 0xbf, 0xfd, 0x82, 0x3c, 0x70, 0xe9, 0x74, 0x16, 0xc6, 0xff, 0x55, 0xb0,
-	0x50, 0xb6, 0x85, 0x6e, 0xb0, 0xf2, 0xef, 0xc6, 0x10, 0x54, 0xc7, 0x4e, 0x87, 0xdb, 0x60, 0xa5,
-	0x75, 0xeb, 0x56, 0xb7, 0xb3, 0xb7, 0xe7, 0xef, 0xdf, 0xde, 0xed, 0xf8, 0x3b, 0x9e, 0xdf, 0xbe,
-	0xbd, 0xdf, 0xd9, 0xbb, 0x58, 0xb1, 0x2f, 0x7d, 0xf6, 0xb5, 0x0b, 0xc7, 0xb0, 0x3b, 0x5e, 0x7b,
-	0x28, 0xa9, 0x98, 0xa0, 0x78, 0x0d, 0x43, 0xb1, 0x26, 0x28, 0x5e, 0x43, 0x51, 0xec, 0xb9, 0x4f,
-	0xbf, 0x73, 0x2a, 0xde, 0x9f, 0xf3, 0xe0, 0x25, 0x95, 0x2f, 0xfc, 0x7e, 0x06, 0xcc, 0xeb, 0x69,
-	0x81, 0xd7, 0xa6, 0x58, 0x34, 0x39, 0x9e, 0xf6, 0xc6, 0x79, 0xa0, 0xda, 0x00, 0xf4, 0xb3, 0x75,
-	0xd8, 0xfa, 0xca, 0xb2, 0x37, 0xbb, 0x54, 0xe6, 0x19, 0x13, 0x2e, 0x89, 0x63, 0x57, 0x4d, 0x24,
-	0x95, 0x34, 0x13, 0x2e, 0xbf, 0xeb, 0xca, 0x01, 0x75, 0xb5, 0x90, 0xab, 0x6d, 0xae, 0xa3, 0xfb,
-	0xc0, 0x79, 0x23, 0x62, 0xa1, 0xcb, 0xf3, 0x22, 0x96, 0x51, 0x97, 0xf4, 0x8a, 0x65, 0x81, 0xd4,
-	0xd3, 0x0c, 0xdf, 0x1a, 0x48, 0x99, 0x8a, 0x26, 0xc6, 0x63, 0xfd, 0x33, 0xf9, 0xec, 0xf6, 0x62,
-	0xde, 0xc3, 0x09, 0x89, 0x18, 0xfe, 0xa0, 0x0c, 0x89, 0x94, 0x06, 0xb8, 0x71, 0xc3, 0xd7, 0x3a,
-	0xf5, 0x24, 0x7c, 0xf2, 0xcb, 0x1f, 0x5f, 0xce, 0xd4, 0xe0, 0xd5, 0xb2, 0xfd, 0x4e, 0xbd, 0xd8,
-	0xe6, 0xbc, 0x2f, 0x66, 0xc0, 0x82, 0x99, 0x5a, 0x78, 0x66, 0xf9, 0x27, 0x5f, 0x17, 0xfb, 0xfa,
-	0xb9, 0xb0, 0xc6, 0xab, 0x1f, 0xad, 0xc3, 0xd6, 0x13, 0xcb, 0x5e, 0x1e, 0xf7, 0xca, 0xcc, 0x76,
-	0x1d, 0xdd, 0x9b, 0xee, 0x89, 0x19, 0xd7, 0x37, 0xff, 0x9f, 0x27, 0x9e, 0x2f, 0x24, 0x91, 0xb4,
-	0xb4, 0xc4, 0x85, 0xce, 0x14, 0x4b, 0x4c, 0x4a, 0xf0, 0x9b, 0x19, 0x70, 0x61, 0xd4, 0xf1, 0x70,
-	0xf3, 0xac, 0x4a, 0x4f, 0x8f, 0xa7, 0xbd, 0x75, 0x4e, 0xb4, 0x71, 0xe6, 0x27, 0xeb, 0xb0, 0xf5,
-	0x91, 0xf5, 0xf6, 0x87, 0x60, 0xf6, 0xb5, 0x46, 0x03, 0x3e, 0x04, 0xd5, 0x36, 0x09, 0xdd, 0xf2,
-	0x6b, 0x31, 0x00, 0x17, 0x49, 0x9a, 0xc6, 0x51, 0xa0, 0xde, 0x5a, 0x7c, 0x4f, 0x70, 0x06, 0xf7,
-	0x1f, 0xa1, 0x80, 0x87, 0x14, 0x35, 0x77, 0x36, 0x51, 0x42, 0x85, 0x20, 0x7d, 0x8a, 0x9a, 0x28,
-	0x62, 0x07, 0x24, 0x8e, 0x42, 0xb7, 0x18, 0x5a, 0xe1, 0x3e, 0x8c, 0xe4, 0xc0, 0x35, 0xe3, 0xe8,
-	0x16, 0xc3, 0xdf, 0x74, 0x4b, 0x40, 0x66, 0xa4, 0x37, 0x51, 0x48, 0x25, 0x89, 0x62, 0x81, 0x9a,
-	0xef, 0xdf, 0x79, 0xac, 0x7c, 0xb9, 0x06, 0x5f, 0x9d, 0xe2, 0x0b, 0x29, 0xd3, 0xc6, 0x8f, 0x8a,
-	0x03, 0x1e, 0xb7, 0x3b, 0x4f, 0x8f, 0x1c, 0xeb, 0xd9, 0x91, 0x63, 0xfd, 0x7e, 0xe4, 0x58, 0x9f,
-	0x1f, 0x3b, 0x95, 0x67, 0xc7, 0x4e, 0xe5, 0xd7, 0x63, 0xa7, 0xf2, 0xde, 0xf5, 0x33, 0xaf, 0x68,
-	0x74, 0x31, 0xea, 0xfd, 0xeb, 0xcd, 0xab, 0x4f, 0xe5, 0xce, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xa3, 0x94, 0xe2, 0x74, 0x96, 0x08, 0x00, 0x00,
+	// 1825 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x8a, 0xb2, 0x3e, 0x86, 0xb0, 0x4c, 0x8f, 0x25, 0x87, 0xda, 0x48, 0xe4, 0x66, 0x5b,
+	0xdb, 0xb2, 0x6c, 0x73, 0x65, 0xca, 0xc9, 0x41, 0x45, 0xd0, 0x6a, 0x4d, 0xda, 0x91, 0x61, 0x47,
+	0xee, 0x8a, 0x45, 0x91, 0x16, 0xc5, 0x62, 0xc8, 0x9d, 0x90, 0x6b, 0x2f, 0x77, 0x37, 0x3b, 0x43,
+	0xc7, 0x84, 0xe1, 0xb6, 0x08, 0x7a, 0x08, 0x74, 0x68, 0x53, 0x17, 0x28, 0x8a, 0xb4, 0x6a, 0x0b,
+	0x14, 0xc8, 0xa1, 0xe8, 0xa1, 0x68, 0x2f, 0x3d, 0xf4, 0xd8, 0x43, 0x8e, 0x01, 0x7a, 0xe9, 0x49,
+	0x2e, 0xec, 0x5e, 0x7a, 0x35, 0xfa, 0x07, 0x14, 0xf3, 0xb1, 0xe4, 0x2e, 0x29, 0x52, 0x56, 0x9c,
+	0x13, 0x97, 0x6f, 0xdf, 0xef, 0xbd, 0xdf, 0x7b, 0xf3, 0x3e, 0x66, 0xc1, 0x39, 0x8a, 0x7d, 0x07,
+	0x47, 0x6d, 0xd7, 0xa7, 0x46, 0xbd, 0xe3, 0x34, 0x31, 0x35, 0x1e, 0x5c, 0xad, 0x63, 0x8a, 0xae,
+	0x1a, 0x1f, 0x74, 0x70, 0xd4, 0x2d, 0x85, 0x51, 0x40, 0x03, 0xb8, 0xd8, 0x08, 0x48, 0x3b, 0x20,
+	0x25, 0xa1, 0x52, 0x92, 0x2a, 0xea, 0xf9, 0xd1, 0x68, 0xa9, 0xc9, 0xe1, 0xea, 0x9a, 0x80, 0x1b,
+	0x75, 0x44, 0xb0, 0xb0, 0xdb, 0xd3, 0x0b, 0x51, 0xd3, 0xf5, 0x11, 0x75, 0x03, 0x5f, 0xea, 0x2e,
+	0x34, 0x83, 0x66, 0xc0, 0x1f, 0x0d, 0xf6, 0x24, 0xa5, 0x4b, 0xcd, 0x20, 0x68, 0x7a, 0xd8, 0xe0,
+	0xff, 0xea, 0x9d, 0xf7, 0x0d, 0xe4, 0x4b, 0x6e, 0xea, 0xb2, 0x7c, 0x85, 0x42, 0xd7, 0x40, 0xbe,
+	0x1f, 0x50, 0x6e, 0x8d, 0xc4, 0x40, 0xe1, 0xda, 0x16, 0x16, 0x65, 0x18, 0xe2, 0x55, 0x21, 0xc9,
+	0x2a, 0xe6, 0xd3, 0x08, 0xdc, 0x98, 0x89, 0xf8, 0x69, 0x5c, 0x69, 0x62, 0xff, 0x4a, 0x10, 0x62,
+	0x1f, 0x85, 0xee, 0x83, 0xb2, 0x11, 0x84, 0xdc, 0xfc, 0x21, 0xae, 0x8a, 0x83, 0x1c, 0xa9, 0xdb,
+	0xc6, 0x84, 0xa2, 0x76, 0x18, 0x3b, 0x1c, 0x54, 0x70, 0x3a, 0x51, 0x22, 0x74, 0x7d, 0x01, 0xc0,
+	0x6f, 0xb3, 0xe4, 0xdc, 0x45, 0x11, 0x6a, 0x13, 0x0b, 0x7f, 0xd0, 0xc1, 0x84, 0xea, 0x16, 0x38,
+	0x93, 0x92, 0x92, 0x30, 0xf0, 0x09, 0x86, 0xdf, 0x00, 0xd3, 0x21, 0x97, 0xe4, 0x15, 0x4d, 0x59,
+	0xcd, 0x96, 0x57, 0x4a, 0x87, 0x9e, 0x51, 0x49, 0xc0, 0xcc, 0xa9, 0xcf, 0x0f, 0x8a, 0x13, 0x96,
+	0x84, 0xe8, 0x9f, 0x66, 0xa4, 0x51, 0x93, 0x2b, 0xc7, 0xbe, 0x20, 0x04, 0x53, 0x3e, 0x6a, 0x63,
+	0x6e, 0x72, 0xce, 0xe2, 0xcf, 0xf0, 0x1c, 0x98, 0x27, 0x41, 0x27, 0x6a, 0x60, 0x1b, 0x39, 0x4e,
+	0x84, 0x09, 0xc9, 0x4f, 0xf2, 0xb7, 0x27, 0x85, 0x74, 0x4b, 0x08, 0xa1, 0x01, 0xce, 0x38, 0x98,
+	0x50, 0x79, 0x98, 0x3d, 0xdd, 0x0c, 0xd7, 0x85, 0x89, 0x57, 0x31, 0x40, 0x03, 0xd9, 0x46, 0xe0,
+	0x79, 0xb8, 0x41, 0xdd, 0xba, 0x87, 0xf3, 0x53, 0x9a, 0xb2, 0x3a, 0x6b, 0x25, 0x45, 0x30, 0x07,
+	0x32, 0x14, 0x35, 0xf3, 0x27, 0xb8, 0x09, 0xf6, 0xc8, 0x82, 0x26, 0x14, 0xd1, 0x0e, 0xc9, 0x4f,
+	0x6b, 0xca, 0xea, 0x7c, 0xf9, 0x6b, 0x23, 0x82, 0x16, 0x61, 0xed, 0x72, 0x55, 0x4b, 0x42, 0xe0,
+	0x0d, 0x00, 0xfa, 0xd5, 0x96, 0x9f, 0xe1, 0x59, 0x3b, 0xdf, 0x33, 0x80, 0x08, 0x2e, 0x89, 0x92,
+	0xef, 0x67, 0xae, 0x89, 0x65, 0x62, 0xac, 0x04, 0x12, 0xbe, 0x0d, 0x66, 0x83, 0xc8, 0xc1, 0x91,
+	0x5d, 0xef, 0xe6, 0x67, 0x39, 0x0d, 0x7d, 0x2c, 0x8d, 0x1d, 0xa6, 0x6c, 0xcd, 0x70, 0x8c, 0xd9,
+	0x85, 0x45, 0x90, 0x15, 0x70, 0x07, 0xfb, 0x41, 0x3b, 0x3f, 0xc7, 0xa3, 0x03, 0x5c, 0x54, 0x61,
+	0x12, 0xfd, 0x33, 0x05, 0x2c, 0xa4, 0x0f, 0x47, 0x1e, 0x79, 0x15, 0xcc, 0x08, 0x07, 0xec, 0xcc,
+	0x33, 0xab, 0xd9, 0xf2, 0xb9, 0xb1, 0x7e, 0x63, 0x9c, 0x3c, 0xfb, 0x18, 0x0b, 0x6f, 0xa6, 0xf2,
+	0x30, 0xc9, 0xf3, 0x70, 0xe1, 0xc8, 0x3c, 0x08, 0x5b, 0xc9, 0x44, 0xe8, 0xff, 0xcd, 0x80, 0xf9,
+	0xb4, 0x2b, 0x76, 0x40, 0xc2, 0xcd, 0x11, 0x55, 0x29, 0x60, 0x71, 0x55, 0x8a, 0x97, 0xf0, 0x77,
+	0x0a, 0x58, 0xa4, 0x01, 0x45, 0x9e, 0x2d, 0xab, 0x00, 0x3b, 0x36, 0xeb, 0x47, 0x56, 0x71, 0x2c,
+	0xdc, 0xa5, 0x14, 0xc9, 0xd8, 0xd4, 0xf5, 0xc0, 0xf5, 0xcd, 0xbb, 0xcc, 0xd0, 0x8b, 0x83, 0xe2,
+	0x72, 0x17, 0xb5, 0xbd, 0x4d, 0xfd, 0x50, 0x2b, 0xfa, 0x1f, 0x9f, 0x16, 0x57, 0x9b, 0x2e, 0x6d,
+	0x75, 0xea, 0xa5, 0x46, 0xd0, 0x96, 0xc3, 0x40, 0xfe, 0x5c, 0x21, 0xce, 0x7d, 0x83, 0x76, 0x43,
+	0x4c, 0xb8, 0x41, 0x62, 0x9d, 0xe1, 0x36, 0xae, 0xc7, 0x26, 0xb8, 0x10, 0x2e, 0x83, 0x39, 0xfc,
+	0xb0, 0x85, 0x3a, 0x84, 0x62, 0x87, 0x97, 0xf6, 0xac, 0xd5, 0x17, 0xc0, 0xdf, 0x28, 0xe0, 0xf5,
+	0x64, 0x0f, 0x0c, 0x46, 0x31, 0xc5, 0xa3, 0x58, 0x1f, 0x91, 0x92, 0x4a, 0x1f, 0x99, 0xf6, 0x6a,
+	0xae, 0xc9, 0xe0, 0x74, 0x11, 0xdc, 0x18, 0x17, 0xba, 0xb5, 0xe4, 0x8c, 0x32, 0x93, 0x68, 0x9e,
+	0x13, 0xc7, 0x6e, 0x1e, 0xfd, 0x27, 0x0a, 0x58, 0xe1, 0x45, 0x29, 0x8d, 0xba, 0x81, 0xff, 0x8e,
+	0x4b, 0x68, 0x10, 0x75, 0xe3, 0xd9, 0xf1, 0x3a, 0x98, 0x13, 0x86, 0x6c, 0xd7, 0xe1, 0xa7, 0x3f,
+	0x65, 0xcd, 0x0a, 0xc1, 0xb6, 0x03, 0x6f, 0x1c, 0x52, 0x73, 0x5f, 0xa2, 0xf7, 0xf4, 0xbf, 0x28,
+	0xa0, 0x30, 0x8a, 0x86, 0x2c, 0xc1, 0x9b, 0x60, 0x26, 0xc2, 0x8d, 0x20, 0x72, 0xe2, 0x2e, 0xb9,
+	0x30, 0x22, 0xce, 0xbe, 0x09, 0x8b, 0xeb, 0xc7, 0x7d, 0x22, 0xd1, 0x5f, 0x5d, 0x9f, 0x60, 0xf0,
+	0x9a, 0x98, 0xe0, 0x51, 0x70, 0x4f, 0x38, 0xec, 0x0d, 0xdc, 0x5b, 0x60, 0xbe, 0xee, 0x05, 0x8d,
+	0xfb, 0xb6, 0xeb, 0x53, 0x1c, 0x3d, 0x40, 0x9e, 0xec, 0x9b, 0xa5, 0x92, 0xd8, 0x15, 0xa5, 0x78,
+	0x57, 0x94, 0x2a, 0x72, 0x57, 0x98, 0xb3, 0x8c, 0xe5, 0xaf, 0x9e, 0x16, 0x15, 0xeb, 0x24, 0x87,
+	0x6e, 0x4b, 0xa4, 0x7e, 0x1f, 0xe4, 0x87, 0xdd, 0xc8, 0xa4, 0xec, 0x80, 0x6c, 0xd8, 0x17, 0x1f,
+	0x91, 0x18, 0x51, 0x00, 0x7d, 0x33, 0x32, 0x31, 0x49, 0x0b, 0xfa, 0xcf, 0xa6, 0x41, 0x6e, 0x50,
+	0x0f, 0xbe, 0x3d, 0x54, 0x02, 0xa6, 0xf6, 0xec, 0xa0, 0x38, 0x2b, 0x14, 0xb7, 0x2b, 0x2f, 0x0e,
+	0x8a, 0x39, 0x51, 0xc3, 0x3d, 0x35, 0x3d, 0x51, 0x24, 0xf1, 0xf6, 0x99, 0x4c, 0x6c, 0x9f, 0xef,
+	0x82, 0xb3, 0x3e, 0x7e, 0x48, 0xe3, 0x42, 0x67, 0x35, 0xdf, 0xc2, 0x6e, 0xb3, 0x45, 0x79, 0xfb,
+	0x65, 0xcc, 0x37, 0x5e, 0x1c, 0x14, 0x57, 0x84, 0xcd, 0xc3, 0xf5, 0x74, 0x6b, 0x81, 0xbd, 0x48,
+	0xd4, 0x0b, 0x17, 0x43, 0x07, 0xcc, 0xb3, 0x4e, 0x69, 0x23, 0xd6, 0x3c, 0x6c, 0x53, 0xf3, 0x0d,
+	0x94, 0x2d, 0xab, 0x43, 0x99, 0xaf, 0xc5, 0x6b, 0xdc, 0x7c, 0x43, 0x36, 0xe2, 0xa2, 0x70, 0x98,
+	0xc6, 0xeb, 0x9f, 0xf0, 0x33, 0xe9, 0x09, 0x19, 0x0c, 0x7e, 0x6b, 0x68, 0x79, 0xf2, 0x6d, 0x66,
+	0x2e, 0xf5, 0xad, 0xa4, 0xdf, 0xeb, 0x83, 0x7b, 0xf5, 0xa7, 0x0a, 0x38, 0x25, 0x55, 0xea, 0xc8,
+	0x43, 0x7e, 0x03, 0xb3, 0xe5, 0x77, 0xc4, 0x38, 0xbc, 0x25, 0x89, 0x9e, 0x4d, 0xb9, 0x88, 0xf1,
+	0xc7, 0x1b, 0x84, 0x32, 0x00, 0x53, 0x82, 0x39, 0xa1, 0xc1, 0xc9, 0x36, 0x73, 0x4c, 0x42, 0xaf,
+	0x34, 0x99, 0xe7, 0x1b, 0xe9, 0xb9, 0xf6, 0x23, 0x70, 0x3a, 0x3d, 0x12, 0x19, 0xa3, 0xd9, 0x2f,
+	0x39, 0x6b, 0x35, 0x49, 0x34, 0x7f, 0xd8, 0xac, 0xe5, 0x13, 0x36, 0x97, 0x9a, 0xb0, 0xae, 0x4f,
+	0x36, 0xa7, 0x3e, 0xfe, 0x7d, 0x71, 0x42, 0x2f, 0x80, 0x65, 0xde, 0x7e, 0x35, 0xb6, 0x37, 0xcc,
+	0x4e, 0xe4, 0x4b, 0x93, 0xf1, 0x3d, 0xee, 0x6f, 0xf1, 0x04, 0x1d, 0x56, 0x90, 0x4d, 0xfa, 0x4b,
+	0x05, 0x40, 0xb1, 0xb9, 0xea, 0xfc, 0xad, 0x0c, 0x45, 0x39, 0x2a, 0xb9, 0x77, 0x24, 0xe7, 0xa5,
+	0xe4, 0xf2, 0x4b, 0x9a, 0x38, 0x5e, 0x7e, 0x73, 0x74, 0x80, 0x20, 0x1b, 0xfe, 0x8b, 0x9c, 0xba,
+	0x2c, 0x4a, 0xdc, 0x9b, 0x5f, 0x6f, 0x81, 0x29, 0x06, 0xcd, 0x2b, 0x63, 0xef, 0x41, 0x12, 0x56,
+	0xeb, 0x86, 0xd8, 0xe2, 0xfa, 0xec, 0x12, 0xd4, 0x0e, 0x9c, 0x8e, 0x87, 0xed, 0x44, 0xc7, 0x03,
+	0x21, 0x7a, 0x97, 0xf5, 0x7d, 0x3c, 0x0b, 0x32, 0xfd, 0x59, 0xa0, 0x97, 0xc1, 0xd9, 0x41, 0x16,
+	0x32, 0x73, 0x79, 0x30, 0x13, 0xf7, 0x97, 0xb8, 0xba, 0xc6, 0x7f, 0xd7, 0x9e, 0x2a, 0x20, 0x9b,
+	0xb8, 0x86, 0xc1, 0xf3, 0xe0, 0x94, 0xf9, 0x9d, 0xca, 0xcd, 0x6a, 0xcd, 0xde, 0xb1, 0x2a, 0x55,
+	0xcb, 0xde, 0xae, 0xe4, 0x26, 0xd4, 0xd3, 0x7b, 0xfb, 0xda, 0xc9, 0x84, 0xd6, 0x76, 0x05, 0xae,
+	0x81, 0xd3, 0x29, 0xbd, 0x77, 0xb7, 0xee, 0x54, 0x73, 0x8a, 0x7a, 0x66, 0x6f, 0x5f, 0x3b, 0x95,
+	0xd0, 0xe4, 0x5c, 0xdf, 0x04, 0xaf, 0xa5, 0x74, 0x77, 0x6b, 0x5b, 0x56, 0xcd, 0xae, 0x6d, 0xdf,
+	0xa9, 0xe6, 0x26, 0xd5, 0xfc, 0xde, 0xbe, 0xb6, 0x90, 0x40, 0xec, 0x52, 0x14, 0x51, 0x3e, 0x1b,
+	0xbe, 0x09, 0x96, 0x53, 0xb0, 0xda, 0x4e, 0x6d, 0xeb, 0xb6, 0x7d, 0x7d, 0xe7, 0xf6, 0xed, 0xea,
+	0xf5, 0x5a, 0xb5, 0x92, 0xcb, 0xa8, 0x2b, 0x7b, 0xfb, 0xda, 0x52, 0x02, 0x5b, 0x4b, 0x5d, 0x49,
+	0xd4, 0xa9, 0x8f, 0xff, 0x50, 0x98, 0x58, 0xeb, 0x82, 0x6c, 0x22, 0xbf, 0xf0, 0x2a, 0x58, 0xdc,
+	0xaa, 0x54, 0xac, 0xea, 0xee, 0xae, 0x5d, 0x7b, 0xef, 0x6e, 0xd5, 0xde, 0x28, 0xdb, 0xe6, 0x7b,
+	0xb5, 0xea, 0x6e, 0x6e, 0x42, 0x3d, 0xbb, 0xb7, 0xaf, 0xc1, 0x84, 0xee, 0x46, 0xd9, 0xec, 0x52,
+	0x4c, 0x86, 0x20, 0xe5, 0x75, 0x09, 0x51, 0x86, 0x20, 0xe5, 0x75, 0x0e, 0x11, 0xae, 0xcb, 0xff,
+	0x03, 0xe0, 0x04, 0x3f, 0x11, 0xf8, 0xd9, 0x24, 0x98, 0x16, 0x5f, 0x1a, 0xf0, 0xe2, 0x88, 0x22,
+	0x18, 0xfe, 0xb4, 0x51, 0xd7, 0x5e, 0x46, 0x55, 0x1c, 0xb1, 0xfe, 0x0f, 0xe5, 0xc9, 0xd6, 0xaf,
+	0x15, 0xf5, 0xb2, 0x85, 0x69, 0x27, 0xf2, 0x89, 0x86, 0x3c, 0x4f, 0xe3, 0x5f, 0x33, 0x98, 0xe2,
+	0x88, 0x68, 0xc1, 0xfb, 0x1a, 0x6d, 0x61, 0x4d, 0x18, 0xd2, 0x44, 0x21, 0x95, 0xf4, 0xfb, 0xa0,
+	0x70, 0xc3, 0xf5, 0x1d, 0x2d, 0xe8, 0x30, 0x59, 0x84, 0x35, 0x54, 0x67, 0x8f, 0x4c, 0x33, 0x14,
+	0x6c, 0xb7, 0x5b, 0x94, 0x86, 0x64, 0xd3, 0x30, 0x12, 0xed, 0x31, 0xfc, 0x55, 0x5b, 0xf7, 0x82,
+	0xba, 0xd1, 0x46, 0xae, 0x6f, 0x3c, 0x8c, 0x45, 0x24, 0xc4, 0x0d, 0x63, 0xfd, 0x2d, 0x5b, 0xd8,
+	0x29, 0xb5, 0x9d, 0x8f, 0xfe, 0xf9, 0x9f, 0x5f, 0x4c, 0x16, 0xe1, 0x4a, 0xdc, 0x5d, 0x03, 0x1f,
+	0xc4, 0xd2, 0xdf, 0xcf, 0x27, 0xc1, 0x8c, 0xbc, 0xd7, 0xc3, 0xb1, 0xe1, 0xa7, 0xbf, 0xcc, 0xd4,
+	0x4b, 0x2f, 0xa5, 0x2b, 0x73, 0xf5, 0x27, 0xe5, 0xc9, 0xd6, 0x47, 0x8a, 0xba, 0x90, 0xcc, 0x95,
+	0xc0, 0x91, 0x92, 0x7e, 0x0f, 0xbe, 0xf3, 0x6a, 0x31, 0x97, 0x6d, 0x42, 0x11, 0xc5, 0xa5, 0xb6,
+	0x33, 0x3a, 0xbb, 0x02, 0xc0, 0x53, 0xa2, 0xc1, 0xc2, 0x88, 0x94, 0xc4, 0x1f, 0x24, 0x7f, 0x57,
+	0xc0, 0xe9, 0xa1, 0xfb, 0x1c, 0xbc, 0x36, 0x2e, 0xe2, 0x51, 0xb7, 0x50, 0xf5, 0xcd, 0x63, 0xa2,
+	0x64, 0xc6, 0x36, 0x39, 0xd5, 0x6b, 0xb0, 0x3c, 0x9e, 0xaa, 0xf1, 0xa8, 0x77, 0x71, 0x79, 0x6c,
+	0xb4, 0x24, 0xd1, 0x4f, 0x15, 0x90, 0x4d, 0xdc, 0xb9, 0x60, 0x69, 0x6c, 0x55, 0x0f, 0xdd, 0x01,
+	0x55, 0xe3, 0xa5, 0xf5, 0x25, 0xd9, 0x35, 0x4e, 0xf6, 0xeb, 0x50, 0x1f, 0x55, 0x6a, 0x09, 0x32,
+	0x7f, 0x56, 0x40, 0x6e, 0x70, 0xe1, 0xc0, 0x8d, 0x71, 0x1e, 0x47, 0xec, 0x2f, 0xf5, 0xda, 0xf1,
+	0x40, 0x92, 0xeb, 0x55, 0xce, 0xf5, 0x12, 0xbc, 0x38, 0x82, 0xeb, 0xf0, 0xb2, 0x82, 0xbf, 0x9d,
+	0x04, 0x73, 0xbd, 0x11, 0x0f, 0x2f, 0x8f, 0x73, 0x3b, 0xb8, 0x8f, 0xd4, 0x2b, 0x2f, 0xa9, 0x2d,
+	0xd9, 0xfd, 0x55, 0x79, 0xb2, 0xf5, 0x63, 0xe5, 0xd6, 0x0f, 0x41, 0xe6, 0xda, 0xfa, 0x3a, 0xfc,
+	0x50, 0x6f, 0x81, 0x1c, 0x0a, 0x43, 0xcf, 0x6d, 0xf0, 0xed, 0x6e, 0xdc, 0x23, 0x81, 0x0f, 0x6b,
+	0x8f, 0xf4, 0x46, 0xe0, 0x60, 0x7d, 0x73, 0xe3, 0xb2, 0xde, 0xc6, 0x84, 0xa0, 0x26, 0xd6, 0x37,
+	0x75, 0xd7, 0x7f, 0x80, 0x3c, 0xd7, 0xd1, 0xd8, 0x62, 0x22, 0xda, 0x87, 0x2e, 0x6d, 0x69, 0x72,
+	0xe5, 0x68, 0x6c, 0xc1, 0x6d, 0x6a, 0xb1, 0x42, 0x24, 0xd7, 0xff, 0x65, 0xdd, 0xc1, 0x14, 0xb9,
+	0x1e, 0xd1, 0x37, 0xbf, 0xff, 0x83, 0xc7, 0x20, 0x6b, 0x22, 0x47, 0x93, 0xac, 0x79, 0x8a, 0x2e,
+	0xc2, 0x0b, 0x23, 0x52, 0x84, 0x62, 0xda, 0xc6, 0x23, 0xe6, 0xed, 0xb1, 0x59, 0xfd, 0xfc, 0x59,
+	0x41, 0xf9, 0xe2, 0x59, 0x41, 0xf9, 0xf7, 0xb3, 0x82, 0xf2, 0xc9, 0xf3, 0xc2, 0xc4, 0x17, 0xcf,
+	0x0b, 0x13, 0xff, 0x7a, 0x5e, 0x98, 0xf8, 0xde, 0xa5, 0xb1, 0x1d, 0xdd, 0xeb, 0x63, 0xbe, 0xed,
+	0xeb, 0xd3, 0xfc, 0x86, 0xbb, 0xf1, 0xff, 0x01, 0x00, 0x30, 0xfa, 0xd8, 0x30, 0x04, 0x14, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -508,6 +1035,12 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Budgets returns all budgets.
 	Budgets(ctx context.Context, in *QueryBudgetsRequest, opts ...grpc.CallOption) (*QueryBudgetsResponse, error)
+	// CollectionHistory returns the collection records of a budget.
+	CollectionHistory(ctx context.Context, in *QueryCollectionHistoryRequest, opts ...grpc.CallOption) (*QueryCollectionHistoryResponse, error)
+	// Projections returns the next collection of each active budget at the current state.
+	Projections(ctx context.Context, in *QueryProjectionsRequest, opts ...grpc.CallOption) (*QueryProjectionsResponse, error)
+	// TotalBurnedCoins returns the total coins burned by all budgets.
+	TotalBurnedCoins(ctx context.Context, in *QueryTotalBurnedCoinsRequest, opts ...grpc.CallOption) (*QueryTotalBurnedCoinsResponse, error)
 	// Addresses returns an address that can be used as source and destination is derived according to the given type,
 	// module name, and name.
 	Addresses(ctx context.Context, in *QueryAddressesRequest, opts ...grpc.CallOption) (*QueryAddressesResponse, error)
@@ -539,24 +1072,57 @@ func (c *queryClient) Budgets(ctx context.Context, in *QueryBudgetsRequest, opts
 	return out, nil
 }
 
-func (c *queryClient) Addresses(ctx context.Context, in *QueryAddressesRequest, opts ...grpc.CallOption) (*QueryAddressesResponse, error) {
-	out := new(QueryAddressesResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.budget.v1beta1.Query/Addresses", in, out, opts...)
+func (c *queryClient) CollectionHistory(ctx context.Context, in *QueryCollectionHistoryRequest, opts ...grpc.CallOption) (*QueryCollectionHistoryResponse, error) {
+	out := new(QueryCollectionHistoryResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.budget.v1beta1.Query/CollectionHistory", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns parameters of the budget module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Budgets returns all budgets.
-	Budgets(context.Context, *QueryBudgetsRequest) (*QueryBudgetsResponse, error)
-	// Addresses returns an address that can be used as source and destination is derived according to the given type,
-	// module name, and name.
-	Addresses(context.Context, *QueryAddressesRequest) (*QueryAddressesResponse, error)
+func (c *queryClient) Projections(ctx context.Context, in *QueryProjectionsRequest, opts ...grpc.CallOption) (*QueryProjectionsResponse, error) {
+	out := new(QueryProjectionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.budget.v1beta1.Query/Projections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalBurnedCoins(ctx context.Context, in *QueryTotalBurnedCoinsRequest, opts ...grpc.CallOption) (*QueryTotalBurnedCoinsResponse, error) {
+	out := new(QueryTotalBurnedCoinsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.budget.v1beta1.Query/TotalBurnedCoins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Addresses(ctx context.Context, in *QueryAddressesRequest, opts ...grpc.CallOption) (*QueryAddressesResponse, error) {
+	out := new(QueryAddressesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.budget.v1beta1.Query/Addresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns parameters of the budget module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Budgets returns all budgets.
+	Budgets(context.Context, *QueryBudgetsRequest) (*QueryBudgetsResponse, error)
+	// CollectionHistory returns the collection records of a budget.
+	CollectionHistory(context.Context, *QueryCollectionHistoryRequest) (*QueryCollectionHistoryResponse, error)
+	// Projections returns the next collection of each active budget at the current state.
+	Projections(context.Context, *QueryProjectionsRequest) (*QueryProjectionsResponse, error)
+	// TotalBurnedCoins returns the total coins burned by all budgets.
+	TotalBurnedCoins(context.Context, *QueryTotalBurnedCoinsRequest) (*QueryTotalBurnedCoinsResponse, error)
+	// Addresses returns an address that can be used as source and destination is derived according to the given type,
+	// module name, and name.
+	Addresses(context.Context, *QueryAddressesRequest) (*QueryAddressesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -569,6 +1135,15 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) Budgets(ctx context.Context, req *QueryBudgetsRequest) (*QueryBudgetsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Budgets not implemented")
 }
+func (*UnimplementedQueryServer) CollectionHistory(ctx context.Context, req *QueryCollectionHistoryRequest) (*QueryCollectionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectionHistory not implemented")
+}
+func (*UnimplementedQueryServer) Projections(ctx context.Context, req *QueryProjectionsRequest) (*QueryProjectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Projections not implemented")
+}
+func (*UnimplementedQueryServer) TotalBurnedCoins(ctx context.Context, req *QueryTotalBurnedCoinsRequest) (*QueryTotalBurnedCoinsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalBurnedCoins not implemented")
+}
 func (*UnimplementedQueryServer) Addresses(ctx context.Context, req *QueryAddressesRequest) (*QueryAddressesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Addresses not implemented")
 }
@@ -613,6 +1188,60 @@ func _Query_Budgets_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_CollectionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollectionHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CollectionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.budget.v1beta1.Query/CollectionHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CollectionHistory(ctx, req.(*QueryCollectionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Projections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Projections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.budget.v1beta1.Query/Projections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Projections(ctx, req.(*QueryProjectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalBurnedCoins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalBurnedCoinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalBurnedCoins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.budget.v1beta1.Query/TotalBurnedCoins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalBurnedCoins(ctx, req.(*QueryTotalBurnedCoinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Addresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryAddressesRequest)
 	if err := dec(in); err != nil {
@@ -643,6 +1272,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Budgets",
 			Handler:    _Query_Budgets_Handler,
 		},
+		{
+			MethodName: "CollectionHistory",
+			Handler:    _Query_CollectionHistory_Handler,
+		},
+		{
+			MethodName: "Projections",
+			Handler:    _Query_Projections_Handler,
+		},
+		{
+			MethodName: "TotalBurnedCoins",
+			Handler:    _Query_TotalBurnedCoins_Handler,
+		},
 		{
 			MethodName: "Addresses",
 			Handler:    _Query_Addresses_Handler,
@@ -728,6 +1369,52 @@ func (m *QueryBudgetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OrderDenom) > 0 {
+		i -= len(m.OrderDenom)
+		copy(dAtA[i:], m.OrderDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderDenom)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.OrderBy != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OrderBy))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Collectible {
+		i--
+		if m.Collectible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.DestinationAddress) > 0 {
 		i -= len(m.DestinationAddress)
 		copy(dAtA[i:], m.DestinationAddress)
@@ -772,6 +1459,18 @@ func (m *QueryBudgetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Budgets) > 0 {
 		for iNdEx := len(m.Budgets) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -809,6 +1508,35 @@ func (m *BudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DestinationCollectedCoins) > 0 {
+		for iNdEx := len(m.DestinationCollectedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DestinationCollectedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Exhausted {
+		i--
+		if m.Exhausted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.TotalCollectedCoins) > 0 {
 		for iNdEx := len(m.TotalCollectedCoins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -836,7 +1564,7 @@ func (m *BudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAddressesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryCollectionHistoryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -846,39 +1574,37 @@ func (m *QueryAddressesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCollectionHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCollectionHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ModuleName) > 0 {
-		i -= len(m.ModuleName)
-		copy(dAtA[i:], m.ModuleName)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleName)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Type != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+	if m.BudgetId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BudgetId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAddressesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryCollectionHistoryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -888,149 +1614,630 @@ func (m *QueryAddressesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCollectionHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCollectionHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProjectionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryProjectionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBudgetsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryProjectionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.SourceAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.DestinationAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.BlockInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.BlockInterval):])
+	if err7 != nil {
+		return 0, err7
 	}
-	return n
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBudgetsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProjectionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Budgets) > 0 {
-		for _, e := range m.Budgets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Projections) > 0 {
+		for iNdEx := len(m.Projections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BudgetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Budget.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if len(m.TotalCollectedCoins) > 0 {
-		for _, e := range m.TotalCollectedCoins {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *BudgetProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BudgetProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BudgetProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DestinationCoins) > 0 {
+		for iNdEx := len(m.DestinationCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DestinationCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
 		}
 	}
-	return n
+	if len(m.CollectedCoins) > 0 {
+		for iNdEx := len(m.CollectedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollectedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SourceBalances) > 0 {
+		for iNdEx := len(m.SourceBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SourceBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SourceAddress) > 0 {
+		i -= len(m.SourceAddress)
+		copy(dAtA[i:], m.SourceAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SourceAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EstimatedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EstimatedTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	if m.NextCollectionHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextCollectionHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BudgetID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BudgetID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAddressesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTotalBurnedCoinsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalBurnedCoinsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalBurnedCoinsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalBurnedCoinsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalBurnedCoinsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalBurnedCoinsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalBurnedCoins) > 0 {
+		for iNdEx := len(m.TotalBurnedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalBurnedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Type != 0 {
-		n += 1 + sovQuery(uint64(m.Type))
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.ModuleName)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
 	return n
 }
 
-func (m *QueryAddressesResponse) Size() (n int) {
+func (m *QueryParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBudgetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SourceAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DestinationAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Collectible {
+		n += 2
+	}
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OrderBy != 0 {
+		n += 1 + sovQuery(uint64(m.OrderBy))
+	}
+	l = len(m.OrderDenom)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
+func (m *QueryBudgetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Budgets) > 0 {
+		for _, e := range m.Budgets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Budget.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.TotalCollectedCoins) > 0 {
+		for _, e := range m.TotalCollectedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Exhausted {
+		n += 2
+	}
+	if len(m.DestinationCollectedCoins) > 0 {
+		for _, e := range m.DestinationCollectedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryCollectionHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BudgetId != 0 {
+		n += 1 + sovQuery(uint64(m.BudgetId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCollectionHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProjectionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.BlockInterval)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProjectionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for _, e := range m.Projections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BudgetProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BudgetID != 0 {
+		n += 1 + sovQuery(uint64(m.BudgetID))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NextCollectionHeight != 0 {
+		n += 1 + sovQuery(uint64(m.NextCollectionHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EstimatedTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.SourceAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.SourceBalances) > 0 {
+		for _, e := range m.SourceBalances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.CollectedCoins) > 0 {
+		for _, e := range m.CollectedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DestinationCoins) > 0 {
+		for _, e := range m.DestinationCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTotalBurnedCoinsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalBurnedCoinsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalBurnedCoins) > 0 {
+		for _, e := range m.TotalBurnedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAddressesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1054,12 +2261,1017 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBudgetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBudgetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBudgetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collectible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Collectible = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BudgetStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
+			}
+			m.OrderBy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderBy |= BudgetOrder(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBudgetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBudgetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBudgetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budgets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Budgets = append(m.Budgets, BudgetResponse{})
+			if err := m.Budgets[len(m.Budgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BudgetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BudgetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Budget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCollectedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalCollectedCoins = append(m.TotalCollectedCoins, types.Coin{})
+			if err := m.TotalCollectedCoins[len(m.TotalCollectedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exhausted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exhausted = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCollectedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationCollectedCoins = append(m.DestinationCollectedCoins, DestinationCollectedCoins{})
+			if err := m.DestinationCollectedCoins[len(m.DestinationCollectedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BudgetStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollectionHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollectionHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollectionHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BudgetId", wireType)
+			}
+			m.BudgetId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BudgetId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollectionHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollectionHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollectionHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, CollectionRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProjectionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.BlockInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1081,7 +3293,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProjectionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1104,15 +3316,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProjectionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProjectionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Projections", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1139,7 +3351,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Projections = append(m.Projections, BudgetProjection{})
+			if err := m.Projections[len(m.Projections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1164,7 +3377,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBudgetsRequest) Unmarshal(dAtA []byte) error {
+func (m *BudgetProjection) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1187,13 +3400,32 @@ func (m *QueryBudgetsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBudgetsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BudgetProjection: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBudgetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BudgetProjection: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BudgetID", wireType)
+			}
+			m.BudgetID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BudgetID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -1225,7 +3457,59 @@ func (m *QueryBudgetsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextCollectionHeight", wireType)
+			}
+			m.NextCollectionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextCollectionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EstimatedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SourceAddress", wireType)
 			}
@@ -1257,11 +3541,11 @@ func (m *QueryBudgetsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.SourceAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceBalances", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1271,23 +3555,93 @@ func (m *QueryBudgetsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
+			m.SourceBalances = append(m.SourceBalances, types.Coin{})
+			if err := m.SourceBalances[len(m.SourceBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectedCoins = append(m.CollectedCoins, types.Coin{})
+			if err := m.CollectedCoins[len(m.CollectedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationCoins = append(m.DestinationCoins, DestinationCollectedCoins{})
+			if err := m.DestinationCoins[len(m.DestinationCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1310,7 +3664,7 @@ func (m *QueryBudgetsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBudgetsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalBurnedCoinsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1333,46 +3687,12 @@ func (m *QueryBudgetsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBudgetsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalBurnedCoinsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBudgetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalBurnedCoinsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Budgets", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Budgets = append(m.Budgets, BudgetResponse{})
-			if err := m.Budgets[len(m.Budgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1394,7 +3714,7 @@ func (m *QueryBudgetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BudgetResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalBurnedCoinsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1417,48 +3737,15 @@ func (m *BudgetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BudgetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalBurnedCoinsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalBurnedCoinsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Budget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalCollectedCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurnedCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1485,8 +3772,8 @@ func (m *BudgetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalCollectedCoins = append(m.TotalCollectedCoins, types.Coin{})
-			if err := m.TotalCollectedCoins[len(m.TotalCollectedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalBurnedCoins = append(m.TotalBurnedCoins, types.Coin{})
+			if err := m.TotalBurnedCoins[len(m.TotalBurnedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
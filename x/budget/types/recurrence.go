@@ -0,0 +1,74 @@
+package types
+
+import (
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate validates the recurrence.
+func (recurrence Recurrence) Validate() error {
+	switch recurrence.Type {
+	case RecurrenceTypeDaily, RecurrenceTypeWeekly, RecurrenceTypeMonthly:
+		if recurrence.Interval != 0 {
+			return sdkerrors.Wrapf(ErrInvalidRecurrence, "interval must not be set for %s", recurrence.Type)
+		}
+	case RecurrenceTypeInterval:
+		if recurrence.Interval <= 0 {
+			return sdkerrors.Wrapf(ErrInvalidRecurrence, "interval must be positive for %s", recurrence.Type)
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidRecurrence, "unknown recurrence type %s", recurrence.Type)
+	}
+	return nil
+}
+
+// Period returns the index of the period that the given time belongs to, for periods anchored to startTime.
+// The first period starts at startTime and has the index 0.
+func (recurrence Recurrence) Period(startTime, t time.Time) uint64 {
+	if !t.After(startTime) {
+		return 0
+	}
+	switch recurrence.Type {
+	case RecurrenceTypeDaily:
+		return uint64(t.Sub(startTime) / (24 * time.Hour))
+	case RecurrenceTypeWeekly:
+		return uint64(t.Sub(startTime) / (7 * 24 * time.Hour))
+	case RecurrenceTypeMonthly:
+		startTime, t = startTime.UTC(), t.UTC()
+		months := (t.Year()-startTime.Year())*12 + int(t.Month()-startTime.Month())
+		if t.Before(addMonths(startTime, months)) {
+			months--
+		}
+		return uint64(months)
+	case RecurrenceTypeInterval:
+		return uint64(t.Sub(startTime) / recurrence.Interval)
+	}
+	return 0
+}
+
+// PeriodStart returns the time the period with the given index starts at, for periods anchored to startTime.
+func (recurrence Recurrence) PeriodStart(startTime time.Time, period uint64) time.Time {
+	switch recurrence.Type {
+	case RecurrenceTypeDaily:
+		return startTime.Add(time.Duration(period) * 24 * time.Hour)
+	case RecurrenceTypeWeekly:
+		return startTime.Add(time.Duration(period) * 7 * 24 * time.Hour)
+	case RecurrenceTypeMonthly:
+		return addMonths(startTime.UTC(), int(period))
+	case RecurrenceTypeInterval:
+		return startTime.Add(time.Duration(period) * recurrence.Interval)
+	}
+	return startTime
+}
+
+// addMonths returns the time the given number of months after t. The day is clamped to the last day
+// of the month, so that a period anchored to the 31st starts on the last day of shorter months.
+func addMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	firstDay := time.Date(year, month+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	if lastDay := firstDay.AddDate(0, 1, -1).Day(); day > lastDay {
+		day = lastDay
+	}
+	return firstDay.AddDate(0, 0, day-1)
+}
@@ -0,0 +1,109 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/budget/x/budget/types"
+)
+
+func TestRecurrencePeriod(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		recurrence types.Recurrence
+		startTime  time.Time
+		t          time.Time
+		expected   uint64
+	}{
+		{
+			"before the start time",
+			types.Recurrence{Type: types.RecurrenceTypeDaily},
+			types.MustParseRFC3339("2021-08-01T00:00:00Z"),
+			types.MustParseRFC3339("2021-07-01T00:00:00Z"),
+			0,
+		},
+		{
+			"daily",
+			types.Recurrence{Type: types.RecurrenceTypeDaily},
+			types.MustParseRFC3339("2021-08-01T12:00:00Z"),
+			types.MustParseRFC3339("2021-08-03T11:59:59Z"),
+			1,
+		},
+		{
+			"weekly",
+			types.Recurrence{Type: types.RecurrenceTypeWeekly},
+			types.MustParseRFC3339("2021-08-01T00:00:00Z"),
+			types.MustParseRFC3339("2021-08-15T00:00:00Z"),
+			2,
+		},
+		{
+			"monthly before the boundary",
+			types.Recurrence{Type: types.RecurrenceTypeMonthly},
+			types.MustParseRFC3339("2021-01-15T00:00:00Z"),
+			types.MustParseRFC3339("2021-03-14T23:59:59Z"),
+			1,
+		},
+		{
+			"monthly at the boundary",
+			types.Recurrence{Type: types.RecurrenceTypeMonthly},
+			types.MustParseRFC3339("2021-01-15T00:00:00Z"),
+			types.MustParseRFC3339("2021-03-15T00:00:00Z"),
+			2,
+		},
+		{
+			"monthly anchored to the last day of a month",
+			types.Recurrence{Type: types.RecurrenceTypeMonthly},
+			types.MustParseRFC3339("2021-01-31T00:00:00Z"),
+			types.MustParseRFC3339("2021-02-28T00:00:00Z"),
+			1,
+		},
+		{
+			"monthly across years",
+			types.Recurrence{Type: types.RecurrenceTypeMonthly},
+			types.MustParseRFC3339("2021-11-01T00:00:00Z"),
+			types.MustParseRFC3339("2022-02-01T00:00:00Z"),
+			3,
+		},
+		{
+			"interval",
+			types.Recurrence{Type: types.RecurrenceTypeInterval, Interval: 6 * time.Hour},
+			types.MustParseRFC3339("2021-08-01T00:00:00Z"),
+			types.MustParseRFC3339("2021-08-02T05:00:00Z"),
+			4,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.recurrence.Validate())
+			require.Equal(t, tc.expected, tc.recurrence.Period(tc.startTime, tc.t))
+
+			// the period starts at or before the time, and the time it starts at belongs to the period
+			periodStart := tc.recurrence.PeriodStart(tc.startTime, tc.expected)
+			if tc.t.After(tc.startTime) {
+				require.False(t, periodStart.After(tc.t))
+			}
+			require.Equal(t, tc.expected, tc.recurrence.Period(tc.startTime, periodStart))
+			require.Equal(t, tc.expected+1, tc.recurrence.Period(tc.startTime, tc.recurrence.PeriodStart(tc.startTime, tc.expected+1)))
+		})
+	}
+}
+
+func TestRecurrenceValidate(t *testing.T) {
+	for _, tc := range []types.Recurrence{
+		{Type: types.RecurrenceTypeMonthly, Interval: time.Hour},
+		{Type: types.RecurrenceTypeInterval},
+		{Type: types.RecurrenceType(4)},
+	} {
+		require.ErrorIs(t, tc.Validate(), types.ErrInvalidRecurrence)
+	}
+
+	budget := budgets[0]
+	budget.Recurrence = &types.Recurrence{Type: types.RecurrenceTypeDaily}
+	require.NoError(t, budget.Validate())
+
+	budget.StartTime = time.Time{}
+	budget.EndTime = time.Time{}
+	budget.EndHeight = 100
+	require.ErrorIs(t, budget.Validate(), types.ErrInvalidRecurrence)
+}
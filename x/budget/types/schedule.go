@@ -0,0 +1,154 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	// maxHalvings is the number of halvings after which the factor of a schedule is regarded as zero.
+	maxHalvings = 64
+	// halfPowerPrecision is the number of binary digits of the fraction used when calculating a power of one half.
+	halfPowerPrecision = 16
+)
+
+// Validate validates the rate schedule.
+func (schedule RateSchedule) Validate() error {
+	switch schedule.Type {
+	case ScheduleTypeSteps, ScheduleTypeLinear:
+		if len(schedule.Points) == 0 {
+			return sdkerrors.Wrapf(ErrInvalidRateSchedule, "points must not be empty for %s", schedule.Type)
+		}
+		if schedule.Period != 0 {
+			return sdkerrors.Wrapf(ErrInvalidRateSchedule, "period must not be set for %s", schedule.Type)
+		}
+		for i, point := range schedule.Points {
+			if point.Factor.IsNil() || point.Factor.IsNegative() || point.Factor.GT(sdk.OneDec()) {
+				return sdkerrors.Wrapf(ErrInvalidRateSchedule, "factor must be between 0 and 1: %s", point.Factor)
+			}
+			if i > 0 && !point.Time.After(schedule.Points[i-1].Time) {
+				return sdkerrors.Wrapf(ErrInvalidRateSchedule, "points must be sorted by time without duplicates: %s", point.Time)
+			}
+		}
+	case ScheduleTypeExponentialDecay, ScheduleTypeHalving:
+		if schedule.Period <= 0 {
+			return sdkerrors.Wrapf(ErrInvalidRateSchedule, "period must be positive for %s", schedule.Type)
+		}
+		if len(schedule.Points) > 0 {
+			return sdkerrors.Wrapf(ErrInvalidRateSchedule, "points must be empty for %s", schedule.Type)
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidRateSchedule, "unknown schedule type %s", schedule.Type)
+	}
+	return nil
+}
+
+// Factor returns the factor of the schedule at the given time for a budget starting at startTime.
+func (schedule RateSchedule) Factor(startTime, t time.Time) sdk.Dec {
+	switch schedule.Type {
+	case ScheduleTypeSteps:
+		factor := schedule.Points[0].Factor
+		for _, point := range schedule.Points {
+			if point.Time.After(t) {
+				break
+			}
+			factor = point.Factor
+		}
+		return factor
+	case ScheduleTypeLinear:
+		if !t.After(schedule.Points[0].Time) {
+			return schedule.Points[0].Factor
+		}
+		for i := 1; i < len(schedule.Points); i++ {
+			prev, next := schedule.Points[i-1], schedule.Points[i]
+			if t.Before(next.Time) {
+				elapsed := sdk.NewDec(int64(t.Sub(prev.Time)))
+				span := sdk.NewDec(int64(next.Time.Sub(prev.Time)))
+				return prev.Factor.Add(next.Factor.Sub(prev.Factor).Mul(elapsed).Quo(span))
+			}
+		}
+		return schedule.Points[len(schedule.Points)-1].Factor
+	case ScheduleTypeExponentialDecay:
+		if !t.After(startTime) {
+			return sdk.OneDec()
+		}
+		return halfPower(sdk.NewDec(int64(t.Sub(startTime))).QuoInt64(int64(schedule.Period)))
+	case ScheduleTypeHalving:
+		if !t.After(startTime) {
+			return sdk.OneDec()
+		}
+		return halfPower(sdk.NewDec(int64(t.Sub(startTime) / schedule.Period)))
+	}
+	return sdk.OneDec()
+}
+
+// PeakFactor returns the maximum factor of the schedule between the given start and end time, for a budget starting at
+// budgetStartTime.
+func (schedule RateSchedule) PeakFactor(budgetStartTime, startTime, endTime time.Time) sdk.Dec {
+	peak := schedule.Factor(budgetStartTime, startTime)
+	switch schedule.Type {
+	case ScheduleTypeSteps, ScheduleTypeLinear:
+		for _, point := range schedule.Points {
+			if point.Time.After(startTime) && point.Time.Before(endTime) {
+				peak = sdk.MaxDec(peak, point.Factor)
+			}
+		}
+		if schedule.Type == ScheduleTypeLinear {
+			peak = sdk.MaxDec(peak, schedule.Factor(budgetStartTime, endTime))
+		}
+	}
+	// The exponential decay and halving schedules never increase, so the factor at the start time is the peak.
+	return peak
+}
+
+// halfPower returns one half to the power of the non-negative x, approximating the fractional part of x.
+func halfPower(x sdk.Dec) sdk.Dec {
+	half := sdk.NewDecWithPrec(5, 1)
+	n := x.TruncateInt64()
+	if n >= maxHalvings {
+		return sdk.ZeroDec()
+	}
+	result := half.Power(uint64(n))
+	frac := x.Sub(sdk.NewDec(n))
+	root := half
+	for i := 0; i < halfPowerPrecision && frac.IsPositive(); i++ {
+		root, _ = root.ApproxSqrt()
+		frac = frac.MulInt64(2)
+		if frac.GTE(sdk.OneDec()) {
+			result = result.Mul(root)
+			frac = frac.Sub(sdk.OneDec())
+		}
+	}
+	return result
+}
+
+// Scheduled returns the budget with its rates and amount scaled by the factor of its schedule at the given time.
+func (budget Budget) Scheduled(t time.Time) Budget {
+	if budget.Schedule == nil {
+		return budget
+	}
+	factor := budget.Schedule.Factor(budget.StartTime, t)
+	if !budget.Rate.IsNil() {
+		budget.Rate = budget.Rate.Mul(factor)
+	}
+	var denomRates []DenomRate
+	for _, denomRate := range budget.DenomRates {
+		denomRates = append(denomRates, DenomRate{Denom: denomRate.Denom, Rate: denomRate.Rate.Mul(factor)})
+	}
+	budget.DenomRates = denomRates
+	if !budget.Amount.Empty() {
+		budget.Amount, _ = sdk.NewDecCoinsFromCoins(budget.Amount...).MulDecTruncate(factor).TruncateDecimal()
+	}
+	return budget
+}
+
+// PeakDenomRate returns the maximum rate of the denom for the budget between the given start and end time.
+func (budget Budget) PeakDenomRate(denom string, startTime, endTime time.Time) sdk.Dec {
+	rate := budget.DenomRate(denom)
+	if budget.Schedule == nil {
+		return rate
+	}
+	return rate.Mul(budget.Schedule.PeakFactor(budget.StartTime, startTime, endTime))
+}
@@ -0,0 +1,149 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/budget/x/budget/types"
+)
+
+func TestRateScheduleFactor(t *testing.T) {
+	startTime := types.MustParseRFC3339("2021-08-01T00:00:00Z")
+	points := []types.SchedulePoint{
+		{Time: types.MustParseRFC3339("2021-08-02T00:00:00Z"), Factor: sdk.OneDec()},
+		{Time: types.MustParseRFC3339("2021-08-04T00:00:00Z"), Factor: sdk.NewDecWithPrec(5, 1)},
+		{Time: types.MustParseRFC3339("2021-08-05T00:00:00Z"), Factor: sdk.ZeroDec()},
+	}
+
+	for _, tc := range []struct {
+		name     string
+		schedule types.RateSchedule
+		t        time.Time
+		expected sdk.Dec
+	}{
+		{
+			"steps before the first point",
+			types.RateSchedule{Type: types.ScheduleTypeSteps, Points: points},
+			types.MustParseRFC3339("2021-08-01T12:00:00Z"),
+			sdk.OneDec(),
+		},
+		{
+			"steps between points",
+			types.RateSchedule{Type: types.ScheduleTypeSteps, Points: points},
+			types.MustParseRFC3339("2021-08-04T12:00:00Z"),
+			sdk.NewDecWithPrec(5, 1),
+		},
+		{
+			"steps after the last point",
+			types.RateSchedule{Type: types.ScheduleTypeSteps, Points: points},
+			types.MustParseRFC3339("2021-08-06T00:00:00Z"),
+			sdk.ZeroDec(),
+		},
+		{
+			"linear between points",
+			types.RateSchedule{Type: types.ScheduleTypeLinear, Points: points},
+			types.MustParseRFC3339("2021-08-03T00:00:00Z"),
+			sdk.NewDecWithPrec(75, 2),
+		},
+		{
+			"linear at a point",
+			types.RateSchedule{Type: types.ScheduleTypeLinear, Points: points},
+			types.MustParseRFC3339("2021-08-04T00:00:00Z"),
+			sdk.NewDecWithPrec(5, 1),
+		},
+		{
+			"exponential decay after a half-life",
+			types.RateSchedule{Type: types.ScheduleTypeExponentialDecay, Period: 24 * time.Hour},
+			types.MustParseRFC3339("2021-08-02T00:00:00Z"),
+			sdk.NewDecWithPrec(5, 1),
+		},
+		{
+			"exponential decay after two and a half half-lives",
+			types.RateSchedule{Type: types.ScheduleTypeExponentialDecay, Period: 24 * time.Hour},
+			types.MustParseRFC3339("2021-08-03T12:00:00Z"),
+			sdk.MustNewDecFromStr("0.176776695296636881"),
+		},
+		{
+			"halving before the first period ends",
+			types.RateSchedule{Type: types.ScheduleTypeHalving, Period: 24 * time.Hour},
+			types.MustParseRFC3339("2021-08-01T23:59:59Z"),
+			sdk.OneDec(),
+		},
+		{
+			"halving after two periods",
+			types.RateSchedule{Type: types.ScheduleTypeHalving, Period: 24 * time.Hour},
+			types.MustParseRFC3339("2021-08-03T12:00:00Z"),
+			sdk.NewDecWithPrec(25, 2),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.schedule.Validate())
+			require.True(t, tc.expected.Sub(tc.schedule.Factor(startTime, tc.t)).Abs().LTE(sdk.NewDecWithPrec(1, 15)),
+				"expected %s, got %s", tc.expected, tc.schedule.Factor(startTime, tc.t))
+		})
+	}
+}
+
+func TestRateScheduleValidate(t *testing.T) {
+	point := types.SchedulePoint{Time: types.MustParseRFC3339("2021-08-02T00:00:00Z"), Factor: sdk.OneDec()}
+
+	for _, tc := range []types.RateSchedule{
+		{Type: types.ScheduleTypeSteps},
+		{Type: types.ScheduleTypeSteps, Points: []types.SchedulePoint{point, point}},
+		{Type: types.ScheduleTypeLinear, Points: []types.SchedulePoint{{Time: point.Time, Factor: sdk.NewDec(2)}}},
+		{Type: types.ScheduleTypeLinear, Points: []types.SchedulePoint{point}, Period: time.Hour},
+		{Type: types.ScheduleTypeExponentialDecay},
+		{Type: types.ScheduleTypeHalving, Points: []types.SchedulePoint{point}, Period: time.Hour},
+		{Type: types.ScheduleType(4), Period: time.Hour},
+	} {
+		require.ErrorIs(t, tc.Validate(), types.ErrInvalidRateSchedule)
+	}
+}
+
+func TestValidateBudgetsSchedule(t *testing.T) {
+	// budgets[4] and budgets[5] overlap between 2021-08-19 and 2021-08-20 with a total rate of 1.1.
+	budget := budgets[4]
+	budget.Schedule = &types.RateSchedule{
+		Type: types.ScheduleTypeSteps,
+		Points: []types.SchedulePoint{
+			{Time: budget.StartTime, Factor: sdk.OneDec()},
+			{Time: types.MustParseRFC3339("2021-08-19T00:00:00Z"), Factor: sdk.NewDecWithPrec(9, 1)},
+		},
+	}
+	err := types.ValidateBudgets([]types.Budget{budget, budgets[5]})
+	require.NoError(t, err)
+
+	budget.Schedule.Points[1].Time = types.MustParseRFC3339("2021-08-19T00:00:01Z")
+	err = types.ValidateBudgets([]types.Budget{budget, budgets[5]})
+	require.ErrorIs(t, err, types.ErrInvalidTotalBudgetRate)
+
+	budget.Schedule = &types.RateSchedule{Type: types.ScheduleTypeHalving, Period: 24 * time.Hour}
+	err = types.ValidateBudgets([]types.Budget{budget, budgets[5]})
+	require.NoError(t, err)
+}
+
+func TestBudgetScheduled(t *testing.T) {
+	budget := types.Budget{
+		Type:      types.BudgetTypeFixedAmount,
+		Amount:    sdk.NewCoins(sdk.NewInt64Coin("denom1", 1000), sdk.NewInt64Coin("denom2", 1)),
+		StartTime: types.MustParseRFC3339("2021-08-01T00:00:00Z"),
+		Schedule:  &types.RateSchedule{Type: types.ScheduleTypeHalving, Period: 24 * time.Hour},
+	}
+	scheduled := budget.Scheduled(types.MustParseRFC3339("2021-08-02T00:00:00Z"))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 500)), scheduled.Amount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 1000), sdk.NewInt64Coin("denom2", 1)), budget.Amount)
+
+	budget = types.Budget{
+		Rate:       sdk.NewDecWithPrec(5, 1),
+		DenomRates: []types.DenomRate{{Denom: "denom1", Rate: sdk.NewDecWithPrec(2, 1)}},
+		StartTime:  types.MustParseRFC3339("2021-08-01T00:00:00Z"),
+		Schedule:   &types.RateSchedule{Type: types.ScheduleTypeHalving, Period: 24 * time.Hour},
+	}
+	scheduled = budget.Scheduled(types.MustParseRFC3339("2021-08-02T00:00:00Z"))
+	require.Equal(t, sdk.NewDecWithPrec(25, 2), scheduled.Rate)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), scheduled.DenomRate("denom1"))
+	require.Equal(t, sdk.NewDecWithPrec(2, 1), budget.DenomRate("denom1"))
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -23,6 +24,43 @@ func DateRangesOverlap(startTimeA, endTimeA, startTimeB, endTimeB time.Time) boo
 	return startTimeA.Before(endTimeB) && endTimeA.After(startTimeB)
 }
 
+// MinCoins returns the smaller amount of each denom of coinsA compared to coinsB.
+// Denoms that do not exist in coinsB are omitted from the result.
+func MinCoins(coinsA, coinsB sdk.Coins) sdk.Coins {
+	var minCoins sdk.Coins
+	for _, coin := range coinsA {
+		amount := sdk.MinInt(coin.Amount, coinsB.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			minCoins = append(minCoins, sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return minCoins
+}
+
+// MaxCoins returns the larger amount of each denom of coinsA compared to coinsB.
+// Denoms that exist in either of the coins are included in the result.
+func MaxCoins(coinsA, coinsB sdk.Coins) sdk.Coins {
+	maxCoins := sdk.NewCoins(coinsA...)
+	for _, coin := range coinsB {
+		if diff := coin.Amount.Sub(maxCoins.AmountOf(coin.Denom)); diff.IsPositive() {
+			maxCoins = maxCoins.Add(sdk.NewCoin(coin.Denom, diff))
+		}
+	}
+	return maxCoins
+}
+
+// CoinsAbove returns the amount of each denom of the coins above the floor.
+// Denoms that do not exceed the floor are omitted from the result.
+func CoinsAbove(coins, floor sdk.Coins) sdk.Coins {
+	var above sdk.Coins
+	for _, coin := range coins {
+		if amount := coin.Amount.Sub(floor.AmountOf(coin.Denom)); amount.IsPositive() {
+			above = append(above, sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return above
+}
+
 // DeriveAddress derives an address with the given address length type, module name, and
 // address derivation name. It is used to derive source or destination address.
 func DeriveAddress(addressType AddressType, moduleName, name string) sdk.AccAddress {
@@ -35,3 +73,17 @@ func DeriveAddress(addressType AddressType, moduleName, name string) sdk.AccAddr
 		return sdk.AccAddress{}
 	}
 }
+
+// DelegatorAddress returns the address of the delegator account of the budget with the id, which delegates
+// the coins collected by the staking destination of the budget. The address does not change when the budget
+// is renamed, and is never shared by another budget since ids are never reused.
+func DelegatorAddress(budgetID uint64) sdk.AccAddress {
+	return DeriveAddress(AddressType32Bytes, ModuleName, fmt.Sprintf("StakingDelegator/%d", budgetID))
+}
+
+// IBCEscrowAddress returns the address of the IBC escrow account of the budget with the id, which sends the
+// coins collected by the IBC transfer destination of the budget and keeps the coins of the failed transfers
+// that are parked. Like the delegator account, it is derived from the id of the budget.
+func IBCEscrowAddress(budgetID uint64) sdk.AccAddress {
+	return DeriveAddress(AddressType32Bytes, ModuleName, fmt.Sprintf("IBCEscrow/%d", budgetID))
+}
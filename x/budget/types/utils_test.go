@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/tendermint/budget/x/budget/types"
@@ -171,3 +172,19 @@ func TestDeriveAddress(t *testing.T) {
 		})
 	}
 }
+
+func TestDelegatorAndIBCEscrowAddress(t *testing.T) {
+	require.Equal(t, types.DelegatorAddress(1), types.DelegatorAddress(1))
+	require.NotEqual(t, types.DelegatorAddress(1), types.DelegatorAddress(2))
+	require.NotEqual(t, types.IBCEscrowAddress(1), types.IBCEscrowAddress(2))
+	require.NotEqual(t, types.DelegatorAddress(1), types.IBCEscrowAddress(1))
+	require.Len(t, types.DelegatorAddress(1), 32)
+}
+
+func TestMinCoins(t *testing.T) {
+	coinsA := sdk.NewCoins(sdk.NewInt64Coin("denom1", 100), sdk.NewInt64Coin("denom2", 200), sdk.NewInt64Coin("denom3", 300))
+	coinsB := sdk.NewCoins(sdk.NewInt64Coin("denom1", 150), sdk.NewInt64Coin("denom2", 50))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 100), sdk.NewInt64Coin("denom2", 50)), types.MinCoins(coinsA, coinsB))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom1", 100), sdk.NewInt64Coin("denom2", 50)), types.MinCoins(coinsB, coinsA))
+	require.True(t, types.MinCoins(coinsA, sdk.Coins{}).Empty())
+}